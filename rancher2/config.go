@@ -2,6 +2,7 @@ package rancher2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -438,7 +439,7 @@ func (c *Config) GetProjectRoleTemplateBindingsByProjectID(projectID string) ([]
 		return nil, err
 	}
 
-	filters := map[string]interface{}{"ProjectID": projectID}
+	filters := map[string]interface{}{"projectId": projectID}
 	listOpts := NewListOpts(filters)
 
 	collection, err := client.ProjectRoleTemplateBinding.List(listOpts)
@@ -449,10 +450,36 @@ func (c *Config) GetProjectRoleTemplateBindingsByProjectID(projectID string) ([]
 	data := collection.Data
 
 	// Paginating data if needed
-	if collection.Pagination.Partial {
-		for collection, err = collection.Next(); err != nil && collection != nil; collection, err = collection.Next() {
-			data = append(data, collection.Data...)
-		}
+	for collection, err = collection.Next(); err == nil && collection != nil; collection, err = collection.Next() {
+		data = append(data, collection.Data...)
+	}
+
+	return data, err
+}
+
+func (c *Config) GetClusterRoleTemplateBindingsByClusterID(clusterID string) ([]managementClient.ClusterRoleTemplateBinding, error) {
+	if clusterID == "" {
+		return nil, fmt.Errorf("[ERROR] Cluster ID is nil")
+	}
+
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := map[string]interface{}{"clusterId": clusterID}
+	listOpts := NewListOpts(filters)
+
+	collection, err := client.ClusterRoleTemplateBinding.List(listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	data := collection.Data
+
+	// Paginating data if needed
+	for collection, err = collection.Next(); err == nil && collection != nil; collection, err = collection.Next() {
+		data = append(data, collection.Data...)
 	}
 
 	return data, err
@@ -800,6 +827,61 @@ func (c *Config) getObjectV2ByID(clusterID, id, APIType string, resp interface{}
 	}
 }
 
+// listObjectsV2 lists all APIType objects at clusterID matching filters, following pagination
+func (c *Config) listObjectsV2(clusterID, APIType string, filters map[string]interface{}) ([]listItemV2, error) {
+	client, err := c.CatalogV2Client(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []listItemV2{}
+	err = listAllObjects(client, APIType, filters, &out)
+	if err != nil {
+		return nil, fmt.Errorf("Listing %s at cluster ID %s: %w", APIType, clusterID, err)
+	}
+
+	return out, nil
+}
+
+// listAllObjects lists all APIType objects at client matching filters, following pagination. Objects are decoded into
+// out, a pointer to a slice of the object type
+func listAllObjects(client *clientbase.APIBaseClient, APIType string, filters map[string]interface{}, out interface{}) error {
+	if client == nil {
+		return fmt.Errorf("Listing %s: client is nil", APIType)
+	}
+	if len(APIType) == 0 {
+		return fmt.Errorf("Object API type is nil")
+	}
+
+	resp := &rawObjectCollection{}
+	err := client.List(APIType, NewListOpts(filters), resp)
+	if err != nil {
+		return err
+	}
+
+	data := []json.RawMessage{}
+	for {
+		data = append(data, resp.Data...)
+		// Paginating data if needed
+		if resp.Pagination == nil || len(resp.Pagination.Next) == 0 {
+			break
+		}
+		next := resp.Pagination.Next
+		resp = &rawObjectCollection{}
+		err = client.Ops.DoNext(next, resp)
+		if err != nil {
+			return err
+		}
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, out)
+}
+
 func (c *Config) GetSettingV2ByID(id string) (*SettingV2, error) {
 	resp := &SettingV2{}
 	err := c.getObjectV2ByID("local", id, settingV2APIType, resp)
@@ -909,6 +991,29 @@ func (c *Config) isClusterConnected(id string) (bool, *managementClient.Cluster,
 	return c.checkClusterCondition(id, clusterConnectedCondition)
 }
 
+func (c *Config) WaitForClusterConnected(id string, interval time.Duration) (*managementClient.Cluster, error) {
+	if id == "" {
+		return nil, fmt.Errorf("Cluster ID is nil")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), interval)
+	defer cancel()
+	for {
+		connected, obj, err := c.isClusterConnected(id)
+		if err != nil && !IsServerError(err) && !IsNotFound(err) && !IsForbidden(err) {
+			return nil, fmt.Errorf("Getting cluster ID (%s): %v", id, err)
+		}
+		if connected {
+			return obj, nil
+		}
+		select {
+		case <-time.After(rancher2RetriesWait * time.Second):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Timeout waiting for cluster ID %s to be connected", id)
+		}
+	}
+}
+
 func (c *Config) ClusterExist(id string) error {
 	_, err := c.GetClusterByID(id)
 	if err != nil {
@@ -1027,6 +1132,52 @@ func (c *Config) GetUserIDByName(name string) (string, error) {
 	return user.ID, nil
 }
 
+// GetNodeDriverIDByName returns the ID of the node driver named name. Name is returned if not found, built-in node
+// drivers IDs are their names
+func (c *Config) GetNodeDriverIDByName(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("[ERROR] Node Driver name is nil")
+	}
+
+	client, err := c.ManagementClient()
+	if err != nil {
+		return "", err
+	}
+
+	filters := map[string]interface{}{"name": name}
+	collection, err := client.NodeDriver.List(NewListOpts(filters))
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Listing Node Drivers by name %s: %v", name, err)
+	}
+	if len(collection.Data) == 0 {
+		return name, nil
+	}
+
+	return collection.Data[0].ID, nil
+}
+
+// getClientSchemaByID returns the client schema id, requesting it if not cached at the client, like the dynamic schemas
+// of drivers activated after the client creation
+func getClientSchemaByID(client *clientbase.APIBaseClient, id string) (*types.Schema, error) {
+	if client == nil {
+		return nil, fmt.Errorf("[ERROR] Getting schema %s: client is nil", id)
+	}
+	if len(id) == 0 {
+		return nil, fmt.Errorf("[ERROR] Getting schema: id is nil")
+	}
+
+	if s, ok := client.Types[id]; ok {
+		return &s, nil
+	}
+	s := &types.Schema{}
+	err := client.Ops.DoGet(client.Opts.URL+"/schemas/"+id, nil, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
 func (c *Config) activateDriver(id string, interval time.Duration) error {
 	if id == googleConfigDriver {
 		return c.activateKontainerDriver(id, interval)
@@ -1056,6 +1207,10 @@ func (c *Config) activateKontainerDriver(id string, interval time.Duration) erro
 			if driver.State == "active" {
 				return nil
 			}
+			// Download or install failures may leave the driver at any state but active, e.g. inactive or error
+			if condErr := getDriverConditionsError(driver.Conditions); condErr != nil {
+				return fmt.Errorf("[ERROR] Activating Cluster Driver %s: %v", id, condErr)
+			}
 
 			if !updated {
 				err = client.KontainerDriver.ActionActivate(driver)
@@ -1097,6 +1252,12 @@ func (c *Config) activateNodeDriver(id string, interval time.Duration) error {
 			if driver.State == "active" {
 				return nil
 			}
+			// Download or install failures may leave the driver at any state but active, e.g. inactive or error
+			if driver.Status != nil {
+				if condErr := getDriverConditionsError(driver.Status.Conditions); condErr != nil {
+					return fmt.Errorf("[ERROR] Activating Node Driver %s: %v", id, condErr)
+				}
+			}
 
 			if !updated {
 				driver, err = client.NodeDriver.ActionActivate(driver)
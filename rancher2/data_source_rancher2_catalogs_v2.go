@@ -0,0 +1,42 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2CatalogsV2() *schema.Resource {
+	s := &schema.Resource{
+		Read:   dataSourceRancher2CatalogsV2Read,
+		Schema: listFields("catalogs"),
+	}
+	s.Schema["cluster_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Cluster ID to list catalogs V2",
+	}
+
+	return s
+}
+
+func dataSourceRancher2CatalogsV2Read(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	objs, err := meta.(*Config).listObjectsV2(clusterID, catalogV2APIType, expandListFilters(d, nil, nil))
+	if err != nil {
+		return err
+	}
+
+	items := make([]listItem, 0, len(objs))
+	for _, catalog := range objs {
+		items = append(items, listItem{
+			ID:          catalog.ID,
+			Name:        catalog.Name,
+			Labels:      catalog.Labels,
+			Annotations: catalog.Annotations,
+		})
+	}
+
+	d.SetId(clusterID)
+
+	return flattenListItems(d, "catalogs", items)
+}
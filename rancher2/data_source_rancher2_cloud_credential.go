@@ -2,12 +2,13 @@ package rancher2
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 func dataSourceRancher2CloudCredential() *schema.Resource {
-	return &schema.Resource{
+	s := &schema.Resource{
 		Read: dataSourceRancher2CloudCredentialRead,
 
 		Schema: map[string]*schema.Schema{
@@ -25,6 +26,11 @@ func dataSourceRancher2CloudCredential() *schema.Resource {
 			},
 		},
 	}
+	for k, v := range cloudCredentialUsageFields() {
+		s.Schema[k] = v
+	}
+
+	return s
 }
 
 func dataSourceRancher2CloudCredentialRead(d *schema.ResourceData, meta interface{}) error {
@@ -63,5 +69,11 @@ func dataSourceRancher2CloudCredentialRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	return nil
+	usage, err := getCloudCredentialUsage(meta.(*Config), credential.ID, false)
+	if err != nil {
+		log.Printf("[WARN] %v", err)
+		return nil
+	}
+
+	return flattenCloudCredentialUsage(d, usage)
 }
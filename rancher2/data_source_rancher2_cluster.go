@@ -7,7 +7,7 @@ import (
 )
 
 func dataSourceRancher2Cluster() *schema.Resource {
-	return &schema.Resource{
+	s := &schema.Resource{
 		Read: dataSourceRancher2ClusterRead,
 
 		Schema: map[string]*schema.Schema{
@@ -116,6 +116,41 @@ func dataSourceRancher2Cluster() *schema.Resource {
 					Schema: clusterOKEConfigFields(),
 				},
 			},
+			"generic_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: clusterGenericConfigFields(),
+				},
+			},
+			"aks_config_v2_upstream": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Computed:    true,
+				Description: "The AKS cluster upstream spec, as seen by Rancher",
+				Elem: &schema.Resource{
+					Schema: clusterAKSConfigV2Fields(),
+				},
+			},
+			"eks_config_v2_upstream": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Computed:    true,
+				Description: "The EKS cluster upstream spec, as seen by Rancher",
+				Elem: &schema.Resource{
+					Schema: clusterEKSConfigV2Fields(),
+				},
+			},
+			"gke_config_v2_upstream": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Computed:    true,
+				Description: "The GKE cluster upstream spec, as seen by Rancher",
+				Elem: &schema.Resource{
+					Schema: clusterGKEConfigV2Fields(),
+				},
+			},
 			"default_project_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -196,6 +231,12 @@ func dataSourceRancher2Cluster() *schema.Resource {
 			},
 		},
 	}
+
+	for k, v := range clusterKubeConfigFields() {
+		s.Schema[k] = v
+	}
+
+	return s
 }
 
 func dataSourceRancher2ClusterRead(d *schema.ResourceData, meta interface{}) error {
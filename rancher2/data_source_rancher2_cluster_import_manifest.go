@@ -0,0 +1,111 @@
+package rancher2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2ClusterImportManifest() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2ClusterImportManifestRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster V2 ID (<fleet_namespace>/<name>) or cluster V1 ID",
+			},
+			"wait_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until the cluster agent is connected",
+			},
+			"manifest_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster import manifest URL",
+			},
+			"manifest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Cluster import manifest",
+			},
+			"documents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Cluster import manifest YAML documents",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"document_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Cluster import manifest YAML documents count",
+			},
+			"connected": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Cluster agent is connected",
+			},
+		},
+	}
+}
+
+func dataSourceRancher2ClusterImportManifestRead(d *schema.ResourceData, meta interface{}) error {
+	clusterID, err := getClusterV1IDFromClusterID(meta.(*Config), d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+	regToken, err := findClusterRegistrationToken(client, clusterID)
+	if err != nil {
+		return err
+	}
+	if len(regToken.ManifestURL) == 0 {
+		return fmt.Errorf("[ERROR] Cluster %s registration token has no manifest URL", clusterID)
+	}
+
+	manifest, err := DoGet(regToken.ManifestURL, "", "", "", meta.(*Config).CACerts, meta.(*Config).Insecure)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Getting cluster %s import manifest: %v", clusterID, err)
+	}
+	docs, err := splitClusterImportManifest(string(manifest))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Cluster %s: %v", clusterID, err)
+	}
+	if len(docs) == 0 {
+		return fmt.Errorf("[ERROR] Cluster %s import manifest is empty", clusterID)
+	}
+
+	connected := false
+	if d.Get("wait_connected").(bool) {
+		_, err = meta.(*Config).WaitForClusterConnected(clusterID, meta.(*Config).Timeout)
+		if err != nil {
+			return fmt.Errorf("[ERROR] waiting for cluster ID (%s) to be connected: %v", clusterID, err)
+		}
+		connected = true
+	} else {
+		connected, _, err = meta.(*Config).isClusterConnected(clusterID)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(clusterID)
+	d.Set("manifest_url", regToken.ManifestURL)
+	d.Set("manifest", string(manifest))
+	d.Set("documents", docs)
+	d.Set("document_count", len(docs))
+	d.Set("connected", connected)
+
+	return nil
+}
@@ -5,7 +5,7 @@ import (
 )
 
 func dataSourceRancher2ClusterV2() *schema.Resource {
-	return &schema.Resource{
+	s := &schema.Resource{
 		Read: dataSourceRancher2ClusterV2Read,
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,12 @@ func dataSourceRancher2ClusterV2() *schema.Resource {
 			},
 		},
 	}
+
+	for k, v := range clusterKubeConfigFields() {
+		s.Schema[k] = v
+	}
+
+	return s
 }
 
 func dataSourceRancher2ClusterV2Read(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,72 @@
+package rancher2
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2ClusterV2Certificates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2ClusterV2CertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster V2 ID (<fleet_namespace>/<name>) or cluster V1 ID",
+			},
+			"expires_within": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      clusterV2CertificatesExpiresWithinDefault,
+				ValidateFunc: validateDuration,
+				Description:  "List certificates expiring within this duration. All certificates are listed if 0s",
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Cluster certificates expiring within expires_within",
+				Elem: &schema.Resource{
+					Schema: clusterV2CertificateExpirationFields(),
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the cluster certificates expiring within expires_within",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRancher2ClusterV2CertificatesRead(d *schema.ResourceData, meta interface{}) error {
+	clusterID, err := getClusterV1IDFromClusterID(meta.(*Config), d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+	within, err := time.ParseDuration(d.Get("expires_within").(string))
+	if err != nil {
+		return err
+	}
+
+	certificates, err := getClusterCertificatesExpiration(meta.(*Config), clusterID)
+	if err != nil {
+		return err
+	}
+	expiring := flattenClusterV2CertificatesExpiration(certificates, within, time.Now())
+	names := make([]interface{}, len(expiring))
+	for i := range expiring {
+		names[i] = expiring[i].(map[string]interface{})["name"]
+	}
+
+	d.SetId(clusterID)
+	err = d.Set("certificates", expiring)
+	if err != nil {
+		return err
+	}
+	return d.Set("names", names)
+}
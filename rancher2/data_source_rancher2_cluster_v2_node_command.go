@@ -0,0 +1,156 @@
+package rancher2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceRancher2ClusterV2NodeCommand() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2ClusterV2NodeCommandRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster V2 ID (<fleet_namespace>/<name>) or cluster V1 ID",
+			},
+			"os": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      clusterV2NodeCommandOSLinux,
+				ValidateFunc: validation.StringInSlice(clusterV2NodeCommandOSTypes, true),
+				Description:  "Node OS, linux or windows",
+			},
+			"etcd": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Register node with etcd role",
+			},
+			"control_plane": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Register node with control plane role",
+			},
+			"worker": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Register node with worker role",
+			},
+			"node_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Node name",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Node address",
+			},
+			"internal_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Node internal address",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Node labels",
+			},
+			"taints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Node taints",
+				Elem: &schema.Resource{
+					Schema: taintV2Fields(),
+				},
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use insecure node command, skipping Rancher CA checksum verification",
+			},
+			"user_data_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Generate a user-data document running the node command",
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Node registration command",
+			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Cloud-init user-data running the node registration command",
+			},
+		},
+	}
+}
+
+func dataSourceRancher2ClusterV2NodeCommandRead(d *schema.ResourceData, meta interface{}) error {
+	clusterID, err := getClusterV1IDFromClusterID(meta.(*Config), d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+	regToken, err := findClusterRegistrationToken(client, clusterID)
+	if err != nil {
+		return err
+	}
+
+	nodeCommand := expandClusterV2NodeCommand(map[string]interface{}{
+		"os":               d.Get("os"),
+		"etcd":             d.Get("etcd"),
+		"control_plane":    d.Get("control_plane"),
+		"worker":           d.Get("worker"),
+		"node_name":        d.Get("node_name"),
+		"address":          d.Get("address"),
+		"internal_address": d.Get("internal_address"),
+		"labels":           d.Get("labels"),
+		"taints":           d.Get("taints"),
+	})
+
+	insecure := d.Get("insecure").(bool)
+	base := regToken.NodeCommand
+	switch {
+	case nodeCommand.OS == clusterV2NodeCommandOSWindows && insecure:
+		base = regToken.InsecureWindowsNodeCommand
+	case nodeCommand.OS == clusterV2NodeCommandOSWindows:
+		base = regToken.WindowsNodeCommand
+	case insecure:
+		base = regToken.InsecureNodeCommand
+	}
+
+	command, err := nodeCommand.Build(base)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Cluster %s: %v", clusterID, err)
+	}
+	userData := ""
+	if d.Get("user_data_enabled").(bool) {
+		userData, err = nodeCommand.UserData(command)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Cluster %s: %v", clusterID, err)
+		}
+	}
+
+	d.SetId(clusterID)
+	d.Set("command", command)
+	d.Set("user_data", userData)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2Clusters() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceRancher2ClustersRead,
+		Schema: listFields("clusters"),
+	}
+}
+
+func dataSourceRancher2ClustersRead(d *schema.ResourceData, meta interface{}) error {
+	objs, err := meta.(*Config).listObjectsV2(rancher2DefaultLocalClusterID, listClusterV2APIType, expandListFilters(d, nil, nil))
+	if err != nil {
+		return err
+	}
+
+	items := make([]listItem, 0, len(objs))
+	for _, cluster := range objs {
+		name := cluster.Spec.DisplayName
+		if len(name) == 0 {
+			name = cluster.Name
+		}
+		items = append(items, listItem{
+			ID:          cluster.Name,
+			Name:        name,
+			Labels:      cluster.Labels,
+			Annotations: cluster.Annotations,
+		})
+	}
+
+	d.SetId("clusters")
+
+	return flattenListItems(d, "clusters", items)
+}
@@ -0,0 +1,157 @@
+package rancher2
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/rancher/norman/clientbase"
+)
+
+func dataSourceRancher2Drivers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2DriversRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(driverTypes, false),
+				Description:  "Driver type to list, node or cluster. All types if empty",
+			},
+			"drivers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"builtin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"checksum": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config_schema_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Driver dynamic config schema ID",
+						},
+						"fields": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Driver dynamic config schema fields",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"default": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"required": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"sensitive": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRancher2DriversRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	driverType := d.Get("type").(string)
+	filters := map[string]interface{}{
+		"active": true,
+	}
+	drivers := []interface{}{}
+
+	if driverType == "" || driverType == driverTypeNode {
+		nodeDrivers, err := client.NodeDriver.ListAll(NewListOpts(filters))
+		if err != nil {
+			return err
+		}
+		for _, driver := range nodeDrivers.Data {
+			drivers = append(drivers, flattenDataSourceDriver(&client.APIBaseClient, driver.ID, driver.Name, driverTypeNode, driver.Builtin, driver.Checksum, driver.URL, driver.Name+nodeTemplateDriverConfigSuffix))
+		}
+	}
+
+	if driverType == "" || driverType == driverTypeCluster {
+		clusterDrivers, err := client.KontainerDriver.ListAll(NewListOpts(filters))
+		if err != nil {
+			return err
+		}
+		for _, driver := range clusterDrivers.Data {
+			schemaID := driver.Name + clusterDriverConfigSuffix
+			if driver.BuiltIn {
+				schemaID = driver.Name + clusterDriverBuiltinConfigSuffix
+			}
+			drivers = append(drivers, flattenDataSourceDriver(&client.APIBaseClient, driver.ID, driver.Name, driverTypeCluster, driver.BuiltIn, driver.Checksum, driver.URL, schemaID))
+		}
+	}
+
+	d.SetId(driverType + "drivers")
+
+	return d.Set("drivers", drivers)
+}
+
+func flattenDataSourceDriver(client *clientbase.APIBaseClient, id, name, driverType string, builtin bool, checksum, url, schemaID string) map[string]interface{} {
+	obj := map[string]interface{}{
+		"id":               id,
+		"name":             name,
+		"type":             driverType,
+		"builtin":          builtin,
+		"checksum":         checksum,
+		"url":              url,
+		"config_schema_id": schemaID,
+		"fields":           []interface{}{},
+	}
+
+	s, err := getClientSchemaByID(client, schemaID)
+	if err != nil {
+		log.Printf("[WARN] Getting %s driver %s config schema %s: %v", driverType, name, schemaID, err)
+		return obj
+	}
+	obj["fields"] = flattenDriverSchemaFields(s)
+
+	return obj
+}
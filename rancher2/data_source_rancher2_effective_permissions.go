@@ -0,0 +1,193 @@
+package rancher2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func dataSourceRancher2EffectivePermissions() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceRancher2EffectivePermissionsRead,
+		Schema: effectivePermissionsFields(),
+	}
+}
+
+func dataSourceRancher2EffectivePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	scope := d.Get("scope").(string)
+	clusterID := d.Get("cluster_id").(string)
+	projectID := d.Get("project_id").(string)
+	switch scope {
+	case effectivePermissionsScopeCluster:
+		if len(clusterID) == 0 {
+			return fmt.Errorf("[ERROR] cluster_id is required for %s scope", scope)
+		}
+	case effectivePermissionsScopeProject:
+		if len(projectID) == 0 {
+			return fmt.Errorf("[ERROR] project_id is required for %s scope", scope)
+		}
+		clusterID, err = clusterIDFromProjectID(projectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	principal := d.Get("user_id").(string)
+	principalIDs := []string{}
+	if len(principal) > 0 {
+		user, err := client.User.ByID(principal)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Getting user %s: %v", principal, err)
+		}
+		principalIDs = append([]string{user.ID}, user.PrincipalIDs...)
+		// Resolving permissions granted through user group membership
+		groupPrincipalIDs, err := getEffectivePermissionsUserGroupPrincipalIDs(meta.(*Config), user.ID)
+		if err != nil {
+			return err
+		}
+		principalIDs = append(principalIDs, groupPrincipalIDs...)
+	} else {
+		principal = d.Get("group_principal_id").(string)
+		if len(principal) == 0 {
+			return fmt.Errorf("[ERROR] user_id or group_principal_id should be provided")
+		}
+		principalIDs = append(principalIDs, principal)
+	}
+	isPrincipal := func(ids ...string) bool {
+		return isEffectivePermissionsPrincipal(principalIDs, ids...)
+	}
+
+	roleTemplates := map[string]*managementClient.RoleTemplate{}
+	getRoleTemplate := func(id string) (*managementClient.RoleTemplate, error) {
+		if roleTemplate, ok := roleTemplates[id]; ok {
+			return roleTemplate, nil
+		}
+		roleTemplate, err := client.RoleTemplate.ByID(id)
+		if err != nil {
+			return nil, err
+		}
+		roleTemplates[id] = roleTemplate
+		return roleTemplate, nil
+	}
+
+	rules := []effectivePermissionsRule{}
+
+	// Global role bindings grant global role rules, and inherited cluster roles at every cluster but local
+	globalRoleBindings, err := getEffectivePermissionsGlobalRoleBindings(client)
+	if err != nil {
+		return err
+	}
+	for _, binding := range globalRoleBindings {
+		if !isPrincipal(binding.UserID, binding.UserPrincipalID, binding.GroupPrincipalID) {
+			continue
+		}
+		globalRole, err := client.GlobalRole.ByID(binding.GlobalRoleID)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Getting global role %s: %v", binding.GlobalRoleID, err)
+		}
+		grant := effectivePermissionsGrant{
+			BindingType: effectivePermissionsBindingGlobal,
+			BindingID:   binding.ID,
+			RolePath:    []string{globalRole.ID},
+		}
+		if scope == effectivePermissionsScopeGlobal {
+			rules = append(rules, expandEffectivePermissionsRules(globalRole.Rules, grant)...)
+			continue
+		}
+		if clusterID == effectivePermissionsLocalClusterID {
+			continue
+		}
+		for _, id := range globalRole.InheritedClusterRoles {
+			inherited, err := expandEffectivePermissionsRoleTemplateRules(id, grant, getRoleTemplate)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, inherited...)
+		}
+	}
+
+	// Cluster role template bindings apply to cluster and project scopes
+	if scope != effectivePermissionsScopeGlobal {
+		bindings, err := meta.(*Config).GetClusterRoleTemplateBindingsByClusterID(clusterID)
+		if err != nil {
+			return err
+		}
+		for _, binding := range bindings {
+			if !isPrincipal(binding.UserID, binding.UserPrincipalID, binding.GroupPrincipalID) {
+				continue
+			}
+			grant := effectivePermissionsGrant{
+				BindingType: effectivePermissionsBindingCluster,
+				BindingID:   binding.ID,
+			}
+			inherited, err := expandEffectivePermissionsRoleTemplateRules(binding.RoleTemplateID, grant, getRoleTemplate)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, inherited...)
+		}
+	}
+
+	if scope == effectivePermissionsScopeProject {
+		bindings, err := meta.(*Config).GetProjectRoleTemplateBindingsByProjectID(projectID)
+		if err != nil {
+			return err
+		}
+		for _, binding := range bindings {
+			if !isPrincipal(binding.UserID, binding.UserPrincipalID, binding.GroupPrincipalID) {
+				continue
+			}
+			grant := effectivePermissionsGrant{
+				BindingType: effectivePermissionsBindingProject,
+				BindingID:   binding.ID,
+			}
+			inherited, err := expandEffectivePermissionsRoleTemplateRules(binding.RoleTemplateID, grant, getRoleTemplate)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, inherited...)
+		}
+	}
+
+	id := scope + ":" + principal
+	switch scope {
+	case effectivePermissionsScopeCluster:
+		id = scope + ":" + clusterID + ":" + principal
+	case effectivePermissionsScopeProject:
+		id = scope + ":" + projectID + ":" + principal
+	}
+	d.SetId(id)
+
+	return d.Set("rules", flattenEffectivePermissionsRules(mergeEffectivePermissionsRules(rules)))
+}
+
+func getEffectivePermissionsGlobalRoleBindings(client *managementClient.Client) ([]effectivePermissionsGlobalRoleBinding, error) {
+	data := []effectivePermissionsGlobalRoleBinding{}
+	err := listAllObjects(&client.APIBaseClient, managementClient.GlobalRoleBindingType, nil, &data)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Listing global role bindings: %v", err)
+	}
+
+	return data, nil
+}
+
+// getEffectivePermissionsUserGroupPrincipalIDs returns the group principal IDs of user ID, from its user attribute
+func getEffectivePermissionsUserGroupPrincipalIDs(c *Config, userID string) ([]string, error) {
+	userAttribute := map[string]interface{}{}
+	err := c.getObjectV2ByID(rancher2DefaultLocalClusterID, userID, effectivePermissionsUserAttributeV2APIType, &userAttribute)
+	if err != nil {
+		if IsNotFound(err) {
+			// User attribute is created at first user login
+			return nil, nil
+		}
+		return nil, fmt.Errorf("[ERROR] Getting user attribute %s: %v", userID, err)
+	}
+
+	return flattenEffectivePermissionsGroupPrincipalIDs(userAttribute), nil
+}
@@ -56,6 +56,14 @@ func dataSourceRancher2GlobalRole() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"namespaced_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Global role policy rules granted at specific namespaces of the local cluster",
+				Elem: &schema.Resource{
+					Schema: globalRoleNamespacedRulesFields(),
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,81 @@
+package rancher2
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	norman "github.com/rancher/norman/types"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func dataSourceRancher2MachineConfigV2FromNodeTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2MachineConfigV2FromNodeTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Node template ID to translate",
+			},
+			"cloud_credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"driver": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fields": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Machine config V2 driver arguments as strings",
+			},
+			"fields_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Machine config V2 driver arguments as typed JSON",
+			},
+			"machine_config_field": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Machine config V2 driver argument block",
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRancher2MachineConfigV2FromNodeTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	id := meta.(*Config).fixNodeTemplateID(d.Get("node_template_id").(string))
+	nodeTemplate := &NodeTemplate{}
+	err = client.APIBaseClient.ByID(managementClient.NodeTemplateType, id, nodeTemplate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Getting node template %s: %v", id, err)
+	}
+
+	// Custom node driver fields are only known by the driver config schema, needed to withhold passwords
+	var driverSchema *norman.Schema
+	if !slices.Contains(nodeTemplateBuiltinDrivers, nodeTemplate.Driver) {
+		driverSchema, err = getNodeTemplateDriverConfigSchema(meta.(*Config), nodeTemplate.Driver)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(nodeTemplate.ID)
+
+	return flattenMachineConfigV2FromNodeTemplate(d, expandMachineConfigV2FromNodeTemplate(nodeTemplate, driverSchema))
+}
@@ -0,0 +1,55 @@
+package rancher2
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2Namespaces() *schema.Resource {
+	s := &schema.Resource{
+		Read:   dataSourceRancher2NamespacesRead,
+		Schema: listFields("namespaces"),
+	}
+	s.Schema["cluster_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Cluster ID to list namespaces",
+	}
+	s.Schema["project_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Project ID to filter namespaces",
+	}
+
+	return s
+}
+
+func dataSourceRancher2NamespacesRead(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	// Namespaces are labeled with the project ID part, without the cluster ID
+	labels := map[string]interface{}{}
+	if len(projectID) > 0 {
+		labels[listNamespaceProjectIDLabelKey] = projectID[strings.LastIndex(projectID, clusterProjectIDSeparator)+1:]
+	}
+	objs, err := meta.(*Config).listObjectsV2(clusterID, listNamespaceV2APIType, expandListFilters(d, labels, nil))
+	if err != nil {
+		return err
+	}
+
+	items := make([]listItem, 0, len(objs))
+	for _, namespace := range objs {
+		items = append(items, listItem{
+			ID:          namespace.Name,
+			Name:        namespace.Name,
+			Labels:      namespace.Labels,
+			Annotations: namespace.Annotations,
+		})
+	}
+
+	d.SetId(clusterID)
+
+	return flattenListItems(d, "namespaces", items)
+}
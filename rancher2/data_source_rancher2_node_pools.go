@@ -0,0 +1,63 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2NodePools() *schema.Resource {
+	s := &schema.Resource{
+		Read:   dataSourceRancher2NodePoolsRead,
+		Schema: listFields("node_pools"),
+	}
+	s.Schema["cluster_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Cluster ID to list node pools",
+	}
+	s.Schema["node_template_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Node template ID to filter node pools",
+	}
+
+	return s
+}
+
+func dataSourceRancher2NodePoolsRead(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+	nodeTemplateID := d.Get("node_template_id").(string)
+
+	// Node pools are namespaced by their cluster ID
+	fields := map[string]interface{}{
+		"metadata.namespace": clusterID,
+	}
+	objs, err := meta.(*Config).listObjectsV2(rancher2DefaultLocalClusterID, listNodePoolV2APIType, expandListFilters(d, nil, fields))
+	if err != nil {
+		return err
+	}
+
+	items := make([]listItem, 0, len(objs))
+	for _, nodePool := range objs {
+		if nodePool.Namespace != clusterID {
+			continue
+		}
+		// Node template is a spec field, not selectable server side
+		if len(nodeTemplateID) > 0 && nodePool.Spec.NodeTemplateName != nodeTemplateID {
+			continue
+		}
+		name := nodePool.Spec.DisplayName
+		if len(name) == 0 {
+			name = nodePool.Name
+		}
+		items = append(items, listItem{
+			ID:          nodePool.Namespace + clusterProjectIDSeparator + nodePool.Name,
+			Name:        name,
+			Labels:      nodePool.Labels,
+			Annotations: nodePool.Annotations,
+		})
+	}
+
+	d.SetId(clusterID)
+
+	return flattenListItems(d, "node_pools", items)
+}
@@ -0,0 +1,46 @@
+package rancher2
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func dataSourceRancher2Principals() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceRancher2PrincipalsRead,
+		Schema: principalsFields(),
+	}
+}
+
+func dataSourceRancher2PrincipalsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	principalType := d.Get("type").(string)
+	provider := d.Get("auth_provider").(string)
+
+	collection, err := client.Principal.List(nil)
+	if err != nil {
+		return err
+	}
+
+	found, err := client.Principal.CollectionActionSearch(collection, &managementClient.SearchPrincipalsInput{
+		Name:          name,
+		PrincipalType: principalType,
+	})
+	if err != nil {
+		return err
+	}
+
+	principals, ids := flattenPrincipals(filterPrincipals(found.Data, name, principalType, provider, d.Get("limit").(int)))
+
+	d.SetId(strings.Join([]string{name, principalType, provider}, ":"))
+	d.Set("ids", ids)
+
+	return d.Set("principals", principals)
+}
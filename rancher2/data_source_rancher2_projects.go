@@ -0,0 +1,53 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2Projects() *schema.Resource {
+	s := &schema.Resource{
+		Read:   dataSourceRancher2ProjectsRead,
+		Schema: listFields("projects"),
+	}
+	s.Schema["cluster_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Cluster ID to list projects",
+	}
+
+	return s
+}
+
+func dataSourceRancher2ProjectsRead(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	// Projects are namespaced by their cluster ID
+	fields := map[string]interface{}{
+		"metadata.namespace": clusterID,
+	}
+	objs, err := meta.(*Config).listObjectsV2(rancher2DefaultLocalClusterID, listProjectV2APIType, expandListFilters(d, nil, fields))
+	if err != nil {
+		return err
+	}
+
+	items := make([]listItem, 0, len(objs))
+	for _, project := range objs {
+		if project.Namespace != clusterID {
+			continue
+		}
+		name := project.Spec.DisplayName
+		if len(name) == 0 {
+			name = project.Name
+		}
+		items = append(items, listItem{
+			ID:          project.Namespace + clusterProjectIDSeparator + project.Name,
+			Name:        name,
+			Labels:      project.Labels,
+			Annotations: project.Annotations,
+		})
+	}
+
+	d.SetId(clusterID)
+
+	return flattenListItems(d, "projects", items)
+}
@@ -0,0 +1,38 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceRancher2Users() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceRancher2UsersRead,
+		Schema: listFields("users"),
+	}
+}
+
+func dataSourceRancher2UsersRead(d *schema.ResourceData, meta interface{}) error {
+	objs, err := meta.(*Config).listObjectsV2(rancher2DefaultLocalClusterID, listUserV2APIType, expandListFilters(d, nil, nil))
+	if err != nil {
+		return err
+	}
+
+	items := make([]listItem, 0, len(objs))
+	for _, user := range objs {
+		// External users may not have username
+		name := user.Username
+		if len(name) == 0 {
+			name = user.DisplayName
+		}
+		items = append(items, listItem{
+			ID:          user.Name,
+			Name:        name,
+			Labels:      user.Labels,
+			Annotations: user.Annotations,
+		})
+	}
+
+	d.SetId("users")
+
+	return flattenListItems(d, "users", items)
+}
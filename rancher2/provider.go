@@ -110,6 +110,7 @@ func Provider() terraform.ResourceProvider {
 			"rancher2_app_v2":                                        resourceRancher2AppV2(),
 			"rancher2_auth_config_activedirectory":                   resourceRancher2AuthConfigActiveDirectory(),
 			"rancher2_auth_config_adfs":                              resourceRancher2AuthConfigADFS(),
+			"rancher2_auth_config_allowed_principal":                 resourceRancher2AuthConfigAllowedPrincipal(),
 			"rancher2_auth_config_azuread":                           resourceRancher2AuthConfigAzureAD(),
 			"rancher2_auth_config_freeipa":                           resourceRancher2AuthConfigFreeIpa(),
 			"rancher2_auth_config_github":                            resourceRancher2AuthConfigGithub(),
@@ -123,7 +124,10 @@ func Provider() terraform.ResourceProvider {
 			"rancher2_cloud_credential":                              resourceRancher2CloudCredential(),
 			"rancher2_cluster":                                       resourceRancher2Cluster(),
 			"rancher2_cluster_v2":                                    resourceRancher2ClusterV2(),
+			"rancher2_cluster_v2_certificate_rotation":               resourceRancher2ClusterV2CertificateRotation(),
+			"rancher2_cluster_v2_etcd_restore":                       resourceRancher2ClusterV2ETCDRestore(),
 			"rancher2_cluster_driver":                                resourceRancher2ClusterDriver(),
+			"rancher2_cluster_members":                               resourceRancher2ClusterMembers(),
 			"rancher2_cluster_role_template_binding":                 resourceRancher2ClusterRoleTemplateBinding(),
 			"rancher2_cluster_sync":                                  resourceRancher2ClusterSync(),
 			"rancher2_cluster_template":                              resourceRancher2ClusterTemplate(),
@@ -133,13 +137,16 @@ func Provider() terraform.ResourceProvider {
 			"rancher2_feature":                                       resourceRancher2Feature(),
 			"rancher2_global_role":                                   resourceRancher2GlobalRole(),
 			"rancher2_global_role_binding":                           resourceRancher2GlobalRoleBinding(),
+			"rancher2_kubeconfig":                                    resourceRancher2Kubeconfig(),
 			"rancher2_machine_config_v2":                             resourceRancher2MachineConfigV2(),
 			"rancher2_namespace":                                     resourceRancher2Namespace(),
+			"rancher2_node":                                          resourceRancher2Node(),
 			"rancher2_node_driver":                                   resourceRancher2NodeDriver(),
 			"rancher2_node_pool":                                     resourceRancher2NodePool(),
 			"rancher2_node_template":                                 resourceRancher2NodeTemplate(),
 			"rancher2_pod_security_admission_configuration_template": resourceRancher2PodSecurityAdmissionConfigurationTemplate(),
 			"rancher2_project":                                       resourceRancher2Project(),
+			"rancher2_project_members":                               resourceRancher2ProjectMembers(),
 			"rancher2_project_role_template_binding":                 resourceRancher2ProjectRoleTemplateBinding(),
 			"rancher2_registry":                                      resourceRancher2Registry(),
 			"rancher2_role_template":                                 resourceRancher2RoleTemplate(),
@@ -153,25 +160,37 @@ func Provider() terraform.ResourceProvider {
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"rancher2_catalog_v2":                                    dataSourceRancher2CatalogV2(),
+			"rancher2_catalogs_v2":                                   dataSourceRancher2CatalogsV2(),
 			"rancher2_certificate":                                   dataSourceRancher2Certificate(),
 			"rancher2_cloud_credential":                              dataSourceRancher2CloudCredential(),
 			"rancher2_cluster":                                       dataSourceRancher2Cluster(),
 			"rancher2_cluster_v2":                                    dataSourceRancher2ClusterV2(),
+			"rancher2_cluster_v2_certificates":                       dataSourceRancher2ClusterV2Certificates(),
+			"rancher2_cluster_v2_node_command":                       dataSourceRancher2ClusterV2NodeCommand(),
 			"rancher2_cluster_driver":                                dataSourceRancher2ClusterDriver(),
+			"rancher2_cluster_import_manifest":                       dataSourceRancher2ClusterImportManifest(),
 			"rancher2_cluster_role_template_binding":                 dataSourceRancher2ClusterRoleTemplateBinding(),
 			"rancher2_cluster_template":                              dataSourceRancher2ClusterTemplate(),
+			"rancher2_clusters":                                      dataSourceRancher2Clusters(),
 			"rancher2_config_map_v2":                                 dataSourceRancher2ConfigMapV2(),
+			"rancher2_drivers":                                       dataSourceRancher2Drivers(),
+			"rancher2_effective_permissions":                         dataSourceRancher2EffectivePermissions(),
 			"rancher2_etcd_backup":                                   dataSourceRancher2EtcdBackup(),
 			"rancher2_global_role":                                   dataSourceRancher2GlobalRole(),
 			"rancher2_global_role_binding":                           dataSourceRancher2GlobalRoleBinding(),
+			"rancher2_machine_config_v2_from_node_template":          dataSourceRancher2MachineConfigV2FromNodeTemplate(),
 			"rancher2_namespace":                                     dataSourceRancher2Namespace(),
+			"rancher2_namespaces":                                    dataSourceRancher2Namespaces(),
 			"rancher2_node_driver":                                   dataSourceRancher2NodeDriver(),
 			"rancher2_node_pool":                                     dataSourceRancher2NodePool(),
+			"rancher2_node_pools":                                    dataSourceRancher2NodePools(),
 			"rancher2_node_template":                                 dataSourceRancher2NodeTemplate(),
 			"rancher2_pod_security_admission_configuration_template": dataSourceRancher2PodSecurityAdmissionConfigurationTemplate(),
 			"rancher2_principal":                                     dataSourceRancher2Principal(),
+			"rancher2_principals":                                    dataSourceRancher2Principals(),
 			"rancher2_project":                                       dataSourceRancher2Project(),
 			"rancher2_project_role_template_binding":                 dataSourceRancher2ProjectRoleTemplateBinding(),
+			"rancher2_projects":                                      dataSourceRancher2Projects(),
 			"rancher2_registry":                                      dataSourceRancher2Registry(),
 			"rancher2_role_template":                                 dataSourceRancher2RoleTemplate(),
 			"rancher2_secret":                                        dataSourceRancher2Secret(),
@@ -179,6 +198,7 @@ func Provider() terraform.ResourceProvider {
 			"rancher2_setting":                                       dataSourceRancher2Setting(),
 			"rancher2_storage_class_v2":                              dataSourceRancher2StorageClassV2(),
 			"rancher2_user":                                          dataSourceRancher2User(),
+			"rancher2_users":                                         dataSourceRancher2Users(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -26,6 +26,10 @@ func resourceRancher2AuthConfigActiveDirectoryCreate(d *schema.ResourceData, met
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigActiveDirectoryName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigActiveDirectoryName, err)
@@ -37,6 +41,7 @@ func resourceRancher2AuthConfigActiveDirectoryCreate(d *schema.ResourceData, met
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigActiveDirectoryName, err)
 	}
+	authActiveDirectory.AllowedPrincipalIDs, authActiveDirectory.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authActiveDirectory.AllowedPrincipalIDs, authActiveDirectory.Annotations)
 
 	log.Printf("[INFO] +++ %v", authActiveDirectory)
 
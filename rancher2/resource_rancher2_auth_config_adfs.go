@@ -25,6 +25,10 @@ func resourceRancher2AuthConfigADFSCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigADFSName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigADFSName, err)
@@ -36,6 +40,7 @@ func resourceRancher2AuthConfigADFSCreate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigADFSName, err)
 	}
+	authADFS.AllowedPrincipalIDs, authADFS.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authADFS.AllowedPrincipalIDs, authADFS.Annotations)
 
 	// Checking if other auth config is enabled
 	if authADFS.Enabled {
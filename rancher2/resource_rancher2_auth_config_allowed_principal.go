@@ -0,0 +1,161 @@
+package rancher2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// authConfigAllowedPrincipalMutex serializes allowed principals updates done by this provider
+var authConfigAllowedPrincipalMutex sync.Mutex
+
+func resourceRancher2AuthConfigAllowedPrincipal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2AuthConfigAllowedPrincipalCreate,
+		Read:   resourceRancher2AuthConfigAllowedPrincipalRead,
+		Delete: resourceRancher2AuthConfigAllowedPrincipalDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2AuthConfigAllowedPrincipalImport,
+		},
+
+		Schema: authConfigAllowedPrincipalFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2AuthConfigAllowedPrincipalCreate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("auth_config_name").(string)
+	principalID := d.Get("principal_id").(string)
+
+	log.Printf("[INFO] Adding allowed principal %s to Auth Config %s", principalID, name)
+
+	err := updateAuthConfigAllowedPrincipal(meta.(*Config), name, principalID, true, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(name + "." + principalID)
+
+	return resourceRancher2AuthConfigAllowedPrincipalRead(d, meta)
+}
+
+func resourceRancher2AuthConfigAllowedPrincipalRead(d *schema.ResourceData, meta interface{}) error {
+	name, principalID := splitID(d.Id())
+
+	log.Printf("[INFO] Refreshing allowed principal %s of Auth Config %s", principalID, name)
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	auth, err := client.AuthConfig.ByID(name)
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			log.Printf("[INFO] Auth Config %s not found.", name)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if !slices.Contains(auth.AllowedPrincipalIDs, principalID) {
+		log.Printf("[INFO] Allowed principal %s of Auth Config %s not found.", principalID, name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("auth_config_name", name)
+	d.Set("principal_id", principalID)
+
+	return nil
+}
+
+func resourceRancher2AuthConfigAllowedPrincipalDelete(d *schema.ResourceData, meta interface{}) error {
+	name, principalID := splitID(d.Id())
+
+	log.Printf("[INFO] Removing allowed principal %s from Auth Config %s", principalID, name)
+
+	err := updateAuthConfigAllowedPrincipal(meta.(*Config), name, principalID, false, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRancher2AuthConfigAllowedPrincipalImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, principalID := splitID(d.Id())
+	if len(name) == 0 || len(principalID) == 0 {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Importing allowed principal: ID should be <auth_config_name>.<principal_id>")
+	}
+
+	err := resourceRancher2AuthConfigAllowedPrincipalRead(d, meta)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	if len(d.Id()) == 0 {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Importing allowed principal: %s not found at Auth Config %s", principalID, name)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateAuthConfigAllowedPrincipal adds or removes principalID to the auth config allowed principals. The auth config
+// V2 object is read, modified and written with its resourceVersion, so concurrent writers, also from other processes,
+// get a conflict and the change is retried on the fresh object. Just the allowed principals and annotations are changed,
+// the auth config is not re-posted through the V3 API
+func updateAuthConfigAllowedPrincipal(c *Config, name, principalID string, allowed bool, timeout time.Duration) error {
+	if c == nil {
+		return fmt.Errorf("Updating Auth Config allowed principal: Provider config is nil")
+	}
+	if len(name) == 0 || len(principalID) == 0 {
+		return fmt.Errorf("Updating Auth Config allowed principal: Auth Config name and/or principal ID is nil")
+	}
+
+	// Avoid needless conflicts between resources applied in parallel by this provider
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	for {
+		obj := map[string]interface{}{}
+		err := c.getObjectV2ByID("local", name, authConfigV2APIType, &obj)
+		if err != nil {
+			return err
+		}
+		changed, err := expandAuthConfigAllowedPrincipal(obj, principalID, allowed)
+		if err != nil {
+			return fmt.Errorf("Expanding Auth Config %s allowed principal %s: %v", name, principalID, err)
+		}
+		if !changed {
+			return nil
+		}
+		err = c.updateObjectV2("local", name, authConfigV2APIType, obj, nil)
+		if err == nil {
+			return nil
+		}
+		if !IsConflict(err) && !IsServerError(err) {
+			return fmt.Errorf("Updating Auth Config %s allowed principal %s: %v", name, principalID, err)
+		}
+		// Auth config was modified by other writer, reading it again before retry
+		select {
+		case <-time.After(rancher2RetriesWait * time.Second):
+		case <-ctx.Done():
+			return fmt.Errorf("Timeout updating Auth Config %s allowed principal %s: %v", name, principalID, err)
+		}
+	}
+}
@@ -25,6 +25,10 @@ func resourceRancher2AuthConfigAzureADCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigAzureADName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigAzureADName, err)
@@ -36,6 +40,7 @@ func resourceRancher2AuthConfigAzureADCreate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigAzureADName, err)
 	}
+	authAzureAD.AllowedPrincipalIDs, authAzureAD.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authAzureAD.AllowedPrincipalIDs, authAzureAD.Annotations)
 
 	// Checking if other auth config is enabled
 	if authAzureAD.Enabled {
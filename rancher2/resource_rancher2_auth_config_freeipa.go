@@ -26,6 +26,10 @@ func resourceRancher2AuthConfigFreeIpaCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigFreeIpaName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigFreeIpaName, err)
@@ -37,6 +41,7 @@ func resourceRancher2AuthConfigFreeIpaCreate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigFreeIpaName, err)
 	}
+	authFreeIpa.AllowedPrincipalIDs, authFreeIpa.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authFreeIpa.AllowedPrincipalIDs, authFreeIpa.Annotations)
 
 	// Checking if other auth config is enabled
 	if authFreeIpa.Enabled {
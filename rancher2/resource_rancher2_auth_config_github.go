@@ -25,6 +25,10 @@ func resourceRancher2AuthConfigGithubCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigGithubName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigGithubName, err)
@@ -36,6 +40,7 @@ func resourceRancher2AuthConfigGithubCreate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigGithubName, err)
 	}
+	authGithub.AllowedPrincipalIDs, authGithub.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authGithub.AllowedPrincipalIDs, authGithub.Annotations)
 
 	// Checking if other auth config is enabled
 	if authGithub.Enabled {
@@ -25,6 +25,10 @@ func resourceRancher2AuthConfigKeyCloakCreate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigKeyCloakName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigKeyCloakName, err)
@@ -36,6 +40,7 @@ func resourceRancher2AuthConfigKeyCloakCreate(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigKeyCloakName, err)
 	}
+	authKeyCloak.AllowedPrincipalIDs, authKeyCloak.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authKeyCloak.AllowedPrincipalIDs, authKeyCloak.Annotations)
 
 	// Checking if other auth config is enabled
 	if authKeyCloak.Enabled {
@@ -25,6 +25,10 @@ func resourceRancher2AuthConfigOKTACreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigOKTAName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigOKTAName, err)
@@ -36,6 +40,7 @@ func resourceRancher2AuthConfigOKTACreate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigOKTAName, err)
 	}
+	authOKTA.AllowedPrincipalIDs, authOKTA.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authOKTA.AllowedPrincipalIDs, authOKTA.Annotations)
 
 	// Checking if other auth config is enabled
 	if authOKTA.Enabled {
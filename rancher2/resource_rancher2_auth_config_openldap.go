@@ -26,6 +26,10 @@ func resourceRancher2AuthConfigOpenLdapCreate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigOpenLdapName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigOpenLdapName, err)
@@ -37,6 +41,7 @@ func resourceRancher2AuthConfigOpenLdapCreate(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigOpenLdapName, err)
 	}
+	authOpenLdap.AllowedPrincipalIDs, authOpenLdap.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authOpenLdap.AllowedPrincipalIDs, authOpenLdap.Annotations)
 
 	// Checking if other auth config is enabled
 	if authOpenLdap.Enabled {
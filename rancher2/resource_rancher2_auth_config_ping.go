@@ -25,6 +25,10 @@ func resourceRancher2AuthConfigPingCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	// Avoid overwriting allowed principals updated in parallel
+	authConfigAllowedPrincipalMutex.Lock()
+	defer authConfigAllowedPrincipalMutex.Unlock()
+
 	auth, err := client.AuthConfig.ByID(AuthConfigPingName)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to get Auth Config %s: %s", AuthConfigPingName, err)
@@ -36,6 +40,7 @@ func resourceRancher2AuthConfigPingCreate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed expanding Auth Config %s: %s", AuthConfigPingName, err)
 	}
+	authPing.AllowedPrincipalIDs, authPing.Annotations = expandAuthConfigAllowedPrincipals(auth.Annotations, authPing.AllowedPrincipalIDs, authPing.Annotations)
 
 	// Checking if other auth config is enabled
 	if authPing.Enabled {
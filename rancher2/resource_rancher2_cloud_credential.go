@@ -21,6 +21,12 @@ func resourceRancher2CloudCredential() *schema.Resource {
 			State: resourceRancher2CloudCredentialsImport,
 		},
 		Schema: cloudCredentialFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			getSchema := func(driver string) (*norman.Schema, error) {
+				return getCloudCredentialDriverConfigSchema(i.(*Config), driver)
+			}
+			return validateDriverConfig(d, "driver_config", getSchema, nil)
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -40,12 +46,26 @@ func resourceRancher2CloudCredentialCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if nodeDriver, ok := d.Get("driver").(string); ok && nodeDriver != s3ConfigDriver {
+		if cloudCredential.DriverConfig != nil {
+			// Custom node drivers IDs are not their names
+			nodeDriver, err = meta.(*Config).GetNodeDriverIDByName(nodeDriver)
+			if err != nil {
+				return err
+			}
+		}
 		err = meta.(*Config).activateDriver(nodeDriver, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 	}
 
+	if v, ok := d.Get("driver_config").([]interface{}); ok && len(v) > 0 {
+		cloudCredential.DriverConfig, err = expandCloudCredentialDriverConfigWithSchema(meta.(*Config), v)
+		if err != nil {
+			return err
+		}
+	}
+
 	newCloudCredential := &CloudCredential{}
 	err = client.APIBaseClient.Create(managementClient.CloudCredentialType, cloudCredential, newCloudCredential)
 	if err != nil {
@@ -93,6 +113,16 @@ func resourceRancher2CloudCredentialRead(d *schema.ResourceData, meta interface{
 			return resource.NonRetryableError(err)
 		}
 
+		// Usage is informational, keeping the known one if it can't be listed
+		usage, err := getCloudCredentialUsage(meta.(*Config), d.Id(), false)
+		if err != nil {
+			log.Printf("[WARN] %v", err)
+			return nil
+		}
+		if err = flattenCloudCredentialUsage(d, usage); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		return nil
 	})
 }
@@ -128,16 +158,29 @@ func resourceRancher2CloudCredentialUpdate(d *schema.ResourceData, meta interfac
 		update["googlecredentialConfig"] = expandCloudCredentialGoogle(d.Get("google_credential_config").([]interface{}))
 	case harvesterConfigDriver:
 		update["harvestercredentialConfig"] = expandCloudCredentialHarvester(d.Get("harvester_credential_config").([]interface{}))
+	case hetznerConfigDriver:
+		update["hetznercredentialConfig"] = expandCloudCredentialHetzner(d.Get("hetzner_credential_config").([]interface{}))
 	case linodeConfigDriver:
 		update["linodecredentialConfig"] = expandCloudCredentialLinode(d.Get("linode_credential_config").([]interface{}))
+	case opennebulaConfigDriver:
+		update["opennebulacredentialConfig"] = expandCloudCredentialOpennebula(d.Get("opennebula_credential_config").([]interface{}))
 	case openstackConfigDriver:
 		update["openstackcredentialConfig"] = expandCloudCredentialOpenstack(d.Get("openstack_credential_config").([]interface{}))
+	case outscaleConfigDriver:
+		update["outscalecredentialConfig"] = expandCloudCredentialOutscale(d.Get("outscale_credential_config").([]interface{}))
 	case s3ConfigDriver:
 		update["s3credentialConfig"] = expandCloudCredentialS3(d.Get("s3_credential_config").([]interface{}))
 	case vmwarevsphereConfigDriver:
 		update["vmwarevspherecredentialConfig"] = expandCloudCredentialVsphere(d.Get("vsphere_credential_config").([]interface{}))
 	default:
-		return fmt.Errorf("[ERROR] updating cloud credential: Unsupported driver \"%s\"", driver)
+		v, ok := d.Get("driver_config").([]interface{})
+		if !ok || len(v) == 0 {
+			return fmt.Errorf("[ERROR] updating cloud credential: Unsupported driver \"%s\"", driver)
+		}
+		update[driver+cloudCredentialDriverConfigSuffix], err = expandCloudCredentialDriverConfigWithSchema(meta.(*Config), v)
+		if err != nil {
+			return err
+		}
 	}
 
 	newCloudCredential := &CloudCredential{}
@@ -182,6 +225,16 @@ func resourceRancher2CloudCredentialDelete(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	if d.Get("prevent_destroy_if_in_use").(bool) {
+		usage, err := getCloudCredentialUsage(meta.(*Config), id, true)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Cloud Credential %s usage can't be verified, set prevent_destroy_if_in_use to false to remove it anyway: %v", id, err)
+		}
+		if usage.inUse() {
+			return fmt.Errorf("[ERROR] Cloud Credential %s is in use by %s. Remove the references or set prevent_destroy_if_in_use to false", id, usage)
+		}
+	}
+
 	err = client.APIBaseClient.Delete(cloudCredential)
 	if err != nil {
 		return fmt.Errorf("Error removing Cloud Credential: %s", err)
@@ -207,6 +260,67 @@ func resourceRancher2CloudCredentialDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// getCloudCredentialDriverConfigSchema returns the <driver>credentialConfig dynamic schema, available if the node driver is active
+func getCloudCredentialDriverConfigSchema(c *Config, driver string) (*norman.Schema, error) {
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+
+	id := driver + cloudCredentialDriverConfigSuffix
+	s, err := getClientSchemaByID(&client.APIBaseClient, id)
+	if err != nil {
+		return nil, fmt.Errorf("Getting cloud credential driver config schema %s, is %s node driver active?: %v", id, driver, err)
+	}
+
+	return s, nil
+}
+
+// expandCloudCredentialDriverConfigWithSchema returns driver_config fields validated against the driver credential config schema
+func expandCloudCredentialDriverConfigWithSchema(c *Config, p []interface{}) (map[string]interface{}, error) {
+	driver, fields, sensitiveFields := expandDriverConfig(p)
+	s, err := getCloudCredentialDriverConfigSchema(c, driver)
+	if err != nil {
+		return nil, err
+	}
+	err = expandDriverConfigFields(driver, fields, sensitiveFields, s, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeDriverConfigFields(fields, sensitiveFields), nil
+}
+
+// getCloudCredentialUsage returns the cluster V2 machine pools, etcd S3 configs and node templates using the cloud credential.
+// Objects not listable by the user are skipped, unless strict is set, as usage can't be ensured then
+func getCloudCredentialUsage(c *Config, id string, strict bool) (*cloudCredentialUsage, error) {
+	clusters, err := getClustersV2(c)
+	if err != nil {
+		if strict || (!IsForbidden(err) && !IsNotFound(err)) {
+			return nil, fmt.Errorf("Getting cloud credential %s usage: %w", id, err)
+		}
+		log.Printf("[WARN] Getting cloud credential %s usage: cluster V2 not listable: %v", id, err)
+	}
+
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+	filters := map[string]interface{}{
+		managementClient.NodeTemplateFieldCloudCredentialID: id,
+	}
+	nodeTemplates, err := client.NodeTemplate.ListAll(NewListOpts(filters))
+	if err != nil {
+		if strict || (!IsForbidden(err) && !IsNotFound(err)) {
+			return nil, fmt.Errorf("Getting cloud credential %s usage: %w", id, err)
+		}
+		log.Printf("[WARN] Getting cloud credential %s usage: node templates not listable: %v", id, err)
+		nodeTemplates = &managementClient.NodeTemplateCollection{}
+	}
+
+	return expandCloudCredentialUsage(id, clusters, nodeTemplates.Data), nil
+}
+
 // cloudCredentialStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher CloudCredential.
 func cloudCredentialStateRefreshFunc(client *managementClient.Client, credentialID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -25,6 +25,17 @@ func resourceRancher2Cluster() *schema.Resource {
 			State: resourceRancher2ClusterImport,
 		},
 		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			getSchema := func(driver string) (*norman.Schema, error) {
+				return getClusterGenericConfigSchema(i.(*Config), driver)
+			}
+			if err := validateDriverConfig(d, "generic_config", getSchema, []string{clusterGenericConfigDriverName}); err != nil {
+				return err
+			}
+			if readOnly, ok := d.Get("eks_config_v2.0.read_only_node_groups").(bool); ok && readOnly {
+				if imported, ok := d.Get("eks_config_v2.0.imported").(bool); !ok || !imported {
+					return fmt.Errorf("eks_config_v2.0.read_only_node_groups can only be true for imported clusters, eks_config_v2.0.imported should be true")
+				}
+			}
 			if d.Get("driver") == clusterDriverEKSV2 && d.HasChange("eks_config_v2") {
 				old, new := d.GetChange("eks_config_v2")
 				oldObj := expandClusterEKSConfigV2(old.([]interface{}))
@@ -131,6 +142,13 @@ func resourceRancher2ClusterCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if v, ok := d.Get("generic_config").([]interface{}); ok && len(v) > 0 {
+		cluster.GenericConfig, err = expandClusterGenericConfigWithSchema(meta.(*Config), v)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[INFO] Creating Cluster %s", cluster.Name)
 
 	expectedState := []string{"active"}
@@ -334,6 +352,15 @@ func resourceRancher2ClusterUpdate(d *schema.ResourceData, meta interface{}) err
 		update["k3sConfig"] = expandClusterK3SConfig(d.Get("k3s_config").([]interface{}))
 	case clusterDriverRKE2:
 		update["rke2Config"] = expandClusterRKE2Config(d.Get("rke2_config").([]interface{}))
+	default:
+		if v, ok := d.Get("generic_config").([]interface{}); ok && len(v) > 0 {
+			genericConfig, err := expandClusterGenericConfigWithSchema(meta.(*Config), v)
+			if err != nil {
+				return err
+			}
+			genericDriver, _, _ := expandDriverConfig(v)
+			update[genericDriver+clusterDriverConfigSuffix] = genericConfig
+		}
 	}
 
 	// update the cluster; retry til timeout or non retryable error is returned. If api 500 error is received,
@@ -666,3 +693,34 @@ func getClusterKubeconfig(c *Config, id, origconfig string) (*managementClient.G
 		}
 	}
 }
+
+// getClusterGenericConfigSchema returns the <driver>EngineConfig dynamic schema, available if the kontainer driver is active
+func getClusterGenericConfigSchema(c *Config, driver string) (*norman.Schema, error) {
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+
+	id := driver + clusterDriverConfigSuffix
+	s, err := getClientSchemaByID(&client.APIBaseClient, id)
+	if err != nil {
+		return nil, fmt.Errorf("Getting cluster generic config schema %s, is %s cluster driver active?: %v", id, driver, err)
+	}
+
+	return s, nil
+}
+
+// expandClusterGenericConfigWithSchema returns generic_config fields validated against the kontainer driver config schema
+func expandClusterGenericConfigWithSchema(c *Config, p []interface{}) (map[string]interface{}, error) {
+	driver, fields, sensitiveFields := expandDriverConfig(p)
+	s, err := getClusterGenericConfigSchema(c, driver)
+	if err != nil {
+		return nil, err
+	}
+	err = expandDriverConfigFields(driver, fields, sensitiveFields, s, []string{clusterGenericConfigDriverName})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeDriverConfigFields(fields, sensitiveFields), nil
+}
@@ -20,6 +20,12 @@ func resourceRancher2ClusterDriver() *schema.Resource {
 			State: resourceRancher2ClusterDriverImport,
 		},
 		Schema: clusterDriverFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			if !d.Get("validate_url").(bool) || d.Get("builtin").(bool) || !d.NewValueKnown("url") || !d.NewValueKnown("whitelist_domains") {
+				return nil
+			}
+			return validateDriverURL(d.Get("url").(string), toArrayString(d.Get("whitelist_domains").([]interface{})))
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -192,6 +198,12 @@ func clusterDriverStateRefreshFunc(client *managementClient.Client, clusterDrive
 			return nil, "", err
 		}
 
+		if obj.State == "downloading" || obj.State == "activating" {
+			if condErr := getDriverConditionsError(obj.Conditions); condErr != nil {
+				return obj, obj.State, condErr
+			}
+		}
+
 		return obj, obj.State, nil
 	}
 }
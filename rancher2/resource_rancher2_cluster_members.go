@@ -0,0 +1,147 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func resourceRancher2ClusterMembers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ClusterMembersCreate,
+		Read:   resourceRancher2ClusterMembersRead,
+		Update: resourceRancher2ClusterMembersUpdate,
+		Delete: resourceRancher2ClusterMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2ClusterMembersImport,
+		},
+
+		Schema: clusterMembersFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ClusterMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	err := meta.(*Config).ClusterExist(clusterID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Cluster Members %s", clusterID)
+
+	d.SetId(clusterID)
+	err = updateRoleTemplateBindingMembers(d, meta.(*Config), clusterMembersScope, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2ClusterMembersRead(d, meta)
+}
+
+func resourceRancher2ClusterMembersRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Cluster Members ID %s", d.Id())
+
+	_, err := meta.(*Config).GetClusterByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			log.Printf("[INFO] Cluster ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_id", d.Id())
+	return readRoleTemplateBindingMembers(d, meta.(*Config), clusterMembersScope)
+}
+
+func resourceRancher2ClusterMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Cluster Members ID %s", d.Id())
+
+	err := updateRoleTemplateBindingMembers(d, meta.(*Config), clusterMembersScope, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2ClusterMembersRead(d, meta)
+}
+
+func resourceRancher2ClusterMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Cluster Members ID %s", d.Id())
+
+	// Removing just the members managed by the resource
+	err := deleteRoleTemplateBindingMembers(d, meta.(*Config), clusterMembersScope, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRancher2ClusterMembersImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := meta.(*Config).ClusterExist(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.Set("cluster_id", d.Id())
+	d.Set("exclude_owner", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// clusterMembersScope manages cluster role template bindings. They can't be bound to service accounts
+var clusterMembersScope = &roleTemplateBindingMembersScope{
+	Name:                "cluster",
+	OwnerRoleTemplateID: membersClusterOwnerRoleTemplateID,
+	List: func(c *Config, id string) ([]roleTemplateBindingMember, error) {
+		bindings, err := c.GetClusterRoleTemplateBindingsByClusterID(id)
+		if err != nil {
+			return nil, err
+		}
+		members := make([]roleTemplateBindingMember, 0, len(bindings))
+		for _, binding := range bindings {
+			if len(binding.Removed) > 0 {
+				continue
+			}
+			members = append(members, flattenClusterRoleTemplateBindingMember(binding))
+		}
+		return members, nil
+	},
+	Create: func(client *managementClient.Client, id string, member roleTemplateBindingMember) (string, error) {
+		binding, err := client.ClusterRoleTemplateBinding.Create(&managementClient.ClusterRoleTemplateBinding{
+			ClusterID:        id,
+			RoleTemplateID:   member.RoleTemplateID,
+			GroupID:          member.GroupID,
+			GroupPrincipalID: member.GroupPrincipalID,
+			UserID:           member.UserID,
+			UserPrincipalID:  member.UserPrincipalID,
+		})
+		if err != nil {
+			return "", err
+		}
+		return binding.ID, nil
+	},
+	Delete: func(client *managementClient.Client, id string) error {
+		binding, err := client.ClusterRoleTemplateBinding.ByID(id)
+		if err != nil {
+			return err
+		}
+		err = client.ClusterRoleTemplateBinding.Delete(binding)
+		if err != nil {
+			return fmt.Errorf("Deleting cluster role template binding %s: %v", id, err)
+		}
+		return nil
+	},
+	Refresh: clusterRoleTemplateBindingStateRefreshFunc,
+}
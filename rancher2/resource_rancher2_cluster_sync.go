@@ -107,7 +107,10 @@ func resourceRancher2ClusterSyncRead(d *schema.ResourceData, meta interface{}) e
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
-			d.Set("kube_config", kubeConfig.Config)
+			err = setClusterKubeConfig(d, kubeConfig.Config)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
 			nodes, err := meta.(*Config).GetClusterNodes(clusterID)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -32,6 +33,11 @@ func resourceRancher2ClusterV2() *schema.Resource {
 			},
 		},
 		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			if v, ok := d.Get("rke_config").([]interface{}); ok {
+				if err := validateClusterV2RKEConfigMachineSettings(v); err != nil {
+					return err
+				}
+			}
 			if d.HasChange("rke_config") {
 				oldObj, newObj := d.GetChange("rke_config")
 				oldInterface, oldOk := oldObj.([]interface{})
@@ -42,7 +48,7 @@ func resourceRancher2ClusterV2() *schema.Resource {
 					if reflect.DeepEqual(oldConfig, newConfig) {
 						d.Clear("rke_config")
 					} else {
-						d.SetNew("rke_config", flattenClusterV2RKEConfig(newConfig))
+						d.SetNew("rke_config", flattenClusterV2RKEConfigWithMachineSettings(newConfig, newInterface))
 					}
 				}
 			}
@@ -161,6 +167,23 @@ func resourceRancher2ClusterV2Update(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Updating Cluster V2 %s", d.Id())
 
+	// Keeping etcd snapshot restore and certificates rotation if not set at rke_config, they may be managed by
+	// rancher2_cluster_v2_etcd_restore and rancher2_cluster_v2_certificate_rotation resources
+	if cluster.Spec.RKEConfig != nil && (cluster.Spec.RKEConfig.ETCDSnapshotRestore == nil || cluster.Spec.RKEConfig.RotateCertificates == nil) {
+		current, err := getClusterV2ByID(meta.(*Config), d.Id())
+		if err != nil {
+			return err
+		}
+		if current.Spec.RKEConfig != nil {
+			if cluster.Spec.RKEConfig.ETCDSnapshotRestore == nil {
+				cluster.Spec.RKEConfig.ETCDSnapshotRestore = current.Spec.RKEConfig.ETCDSnapshotRestore
+			}
+			if cluster.Spec.RKEConfig.RotateCertificates == nil {
+				cluster.Spec.RKEConfig.RotateCertificates = current.Spec.RKEConfig.RotateCertificates
+			}
+		}
+	}
+
 	newCluster, err := updateClusterV2(meta.(*Config), d.Id(), cluster)
 	if err != nil {
 		return err
@@ -292,6 +315,39 @@ func getClusterV2ByID(c *Config, id string) (*ClusterV2, error) {
 	return resp, nil
 }
 
+func getClustersV2(c *Config) ([]ClusterV2, error) {
+	if c == nil {
+		return nil, fmt.Errorf("Listing cluster V2: Provider config is nil")
+	}
+	client, err := c.CatalogV2Client(rancher2DefaultLocalClusterID)
+	if err != nil {
+		return nil, err
+	}
+	out := []ClusterV2{}
+	err = listAllObjects(client, clusterV2APIType, nil, &out)
+	if err != nil {
+		return nil, fmt.Errorf("Listing cluster V2: %w", err)
+	}
+
+	return out, nil
+}
+
+// getClusterV1IDFromClusterID returns the cluster V1 ID for id, that may be a cluster V2 ID (<fleet_namespace>/<name>)
+// or a cluster V1 ID
+func getClusterV1IDFromClusterID(c *Config, id string) (string, error) {
+	if !strings.Contains(id, clusterV2ClusterIDsep) {
+		return id, nil
+	}
+	cluster, err := getClusterV2ByID(c, id)
+	if err != nil {
+		return "", err
+	}
+	if len(cluster.Status.ClusterName) == 0 {
+		return "", fmt.Errorf("[ERROR] Cluster V2 %s has no cluster V1 ID yet", id)
+	}
+	return cluster.Status.ClusterName, nil
+}
+
 func updateClusterV2(c *Config, id string, obj *ClusterV2) (*ClusterV2, error) {
 	if c == nil {
 		return nil, fmt.Errorf("Updating cluster V2: Provider config is nil")
@@ -416,7 +472,10 @@ func setClusterV2LegacyData(d *schema.ResourceData, c *Config) error {
 	if err != nil {
 		return fmt.Errorf(format, err)
 	}
-	d.Set("kube_config", kubeConfig.Config)
+	err = setClusterKubeConfig(d, kubeConfig.Config)
+	if err != nil {
+		return fmt.Errorf(format, err)
+	}
 
 	return nil
 }
@@ -0,0 +1,163 @@
+package rancher2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	v1 "k8s.io/api/core/v1"
+)
+
+func resourceRancher2ClusterV2CertificateRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ClusterV2CertificateRotationCreate,
+		Read:   resourceRancher2ClusterV2CertificateRotationRead,
+		Delete: resourceRancher2ClusterV2CertificateRotationDelete,
+
+		Schema: clusterV2CertificateRotationFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ClusterV2CertificateRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	cluster, err := getClusterV2ByID(meta.(*Config), clusterID)
+	if err != nil {
+		return err
+	}
+	if cluster.Spec.RKEConfig == nil {
+		return fmt.Errorf("[ERROR] Cluster V2 %s has no rke_config, certificates rotation is not supported", clusterID)
+	}
+
+	// Only the certificates rotation is updated, leaving the rest of the cluster spec as it is
+	rotation := expandClusterV2CertificateRotation(map[string]interface{}{
+		"services": d.Get("services"),
+	}, cluster.Spec.RKEConfig.RotateCertificates)
+	cluster.Spec.RKEConfig.RotateCertificates = rotation
+
+	log.Printf("[INFO] Rotating Cluster V2 %s certificates, generation %d", clusterID, rotation.Generation)
+
+	_, err = updateClusterV2(meta.(*Config), clusterID, cluster)
+	if err != nil {
+		return err
+	}
+	d.SetId(clusterID)
+	d.Set("generation", int(rotation.Generation))
+
+	_, err = waitForClusterV2CertificateRotation(meta.(*Config), clusterID, rotation.Generation, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	_, err = waitForClusterV2State(meta.(*Config), clusterID, clusterV2ActiveCondition, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2ClusterV2CertificateRotationRead(d, meta)
+}
+
+func resourceRancher2ClusterV2CertificateRotationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Cluster V2 certificate rotation %s", d.Id())
+
+	cluster, err := getClusterV2ByID(meta.(*Config), d.Id())
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) || IsNotAccessibleByID(err) {
+			log.Printf("[INFO] Cluster V2 %s not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	certificates, err := getClusterCertificatesExpiration(meta.(*Config), cluster.Status.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("certificates", flattenClusterV2CertificatesExpiration(certificates, 0, time.Now()))
+}
+
+func resourceRancher2ClusterV2CertificateRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	// A certificates rotation can't be reverted, just removing it from tfstate
+	log.Printf("[INFO] Removing Cluster V2 certificate rotation %s from tfstate", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// getClusterCertificatesExpiration returns the cluster certificates expiration dates. Rancher reports them just for RKE1
+// clusters, so the cluster V2 serving certificate is read from the downstream cluster if none are reported
+func getClusterCertificatesExpiration(c *Config, clusterID string) ([]clusterV2CertificateExpiration, error) {
+	if len(clusterID) == 0 {
+		return nil, nil
+	}
+	cluster, err := c.GetClusterByID(clusterID)
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			log.Printf("[INFO] Cluster ID %s not found.", clusterID)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Getting cluster ID (%s) certificates expiration: %w", clusterID, err)
+	}
+	if len(cluster.CertificatesExpiration) > 0 {
+		return expandClusterV2CertificatesExpiration(cluster.CertificatesExpiration), nil
+	}
+
+	certificate, err := getClusterV2ServingCertificateExpiration(c, clusterID)
+	if err != nil {
+		// Downstream cluster may be unreachable
+		log.Printf("[WARN] Getting cluster ID (%s) serving certificate expiration: %v", clusterID, err)
+		return nil, nil
+	}
+	if certificate == nil {
+		return nil, nil
+	}
+
+	return []clusterV2CertificateExpiration{*certificate}, nil
+}
+
+// getClusterV2ServingCertificateExpiration returns the rke2 or k3s serving certificate expiration date, nil if not found
+func getClusterV2ServingCertificateExpiration(c *Config, clusterID string) (*clusterV2CertificateExpiration, error) {
+	for _, name := range clusterV2ServingCertificates {
+		secret := &SecretV2{}
+		err := c.getObjectV2ByID(clusterID, clusterV2CertificatesNamespace+"/"+name, secretV2APIType, secret)
+		if err != nil {
+			if IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("Getting certificate %s: %w", name, err)
+		}
+		return flattenClusterV2ServingCertificateExpiration(name, secret.Data[v1.TLSCertKey])
+	}
+
+	return nil, nil
+}
+
+func waitForClusterV2CertificateRotation(c *Config, id string, generation int64, interval time.Duration) (*ClusterV2RKEControlPlane, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), interval)
+	defer cancel()
+	for {
+		obj, err := getClusterV2RKEControlPlaneByID(c, id)
+		if err != nil {
+			log.Printf("[DEBUG] Retrying on error Refreshing Cluster V2 RKE control plane %s: %v", id, err)
+			if !IsNotFound(err) && !IsForbidden(err) && !IsNotAccessibleByID(err) {
+				return nil, fmt.Errorf("Getting cluster V2 RKE control plane ID (%s): %w", id, err)
+			}
+		}
+		if obj != nil {
+			if obj.Status.CertificateRotationGeneration >= generation {
+				return obj, nil
+			}
+			log.Printf("[DEBUG] Cluster V2 %s certificate rotation generation: %d", id, obj.Status.CertificateRotationGeneration)
+		}
+		select {
+		case <-time.After(rancher2RetriesWait * time.Second):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Timeout waiting for cluster V2 ID %s certificates rotation", id)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package rancher2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+)
+
+func resourceRancher2ClusterV2ETCDRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ClusterV2ETCDRestoreCreate,
+		Read:   resourceRancher2ClusterV2ETCDRestoreRead,
+		Delete: resourceRancher2ClusterV2ETCDRestoreDelete,
+
+		Schema: clusterV2ETCDRestoreFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ClusterV2ETCDRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	cluster, err := getClusterV2ByID(meta.(*Config), clusterID)
+	if err != nil {
+		return err
+	}
+	if cluster.Spec.RKEConfig == nil {
+		return fmt.Errorf("[ERROR] Cluster V2 %s has no rke_config, etcd snapshot restore is not supported", clusterID)
+	}
+
+	machinesBefore, err := getClusterV2Machines(meta.(*Config), cluster)
+	if err != nil {
+		return err
+	}
+
+	// Only the etcd snapshot restore is updated, leaving the rest of the cluster spec as it is
+	restore := expandClusterV2ETCDRestore(map[string]interface{}{
+		"snapshot_name": d.Get("snapshot_name"),
+		"restore_mode":  d.Get("restore_mode"),
+	}, cluster.Spec.RKEConfig.ETCDSnapshotRestore)
+	cluster.Spec.RKEConfig.ETCDSnapshotRestore = restore
+
+	log.Printf("[INFO] Restoring Cluster V2 %s from etcd snapshot %s, generation %d", clusterID, restore.Name, restore.Generation)
+
+	_, err = updateClusterV2(meta.(*Config), clusterID, cluster)
+	if err != nil {
+		return err
+	}
+	d.SetId(flattenClusterV2ETCDRestoreID(clusterID, restore.Name))
+	d.Set("generation", restore.Generation)
+
+	controlPlane, err := waitForClusterV2ETCDRestore(meta.(*Config), clusterID, restore.Generation, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.Set("phase", string(controlPlane.Status.ETCDSnapshotRestorePhase))
+
+	cluster, err = waitForClusterV2State(meta.(*Config), clusterID, clusterV2ActiveCondition, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	machinesAfter, err := getClusterV2Machines(meta.(*Config), cluster)
+	if err != nil {
+		return err
+	}
+	d.Set("machines_rolled", flattenClusterV2ETCDRestoreMachinesRolled(machinesBefore, machinesAfter))
+
+	return resourceRancher2ClusterV2ETCDRestoreRead(d, meta)
+}
+
+func resourceRancher2ClusterV2ETCDRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Cluster V2 etcd restore %s", d.Id())
+
+	clusterID := d.Get("cluster_id").(string)
+	_, err := getClusterV2ByID(meta.(*Config), clusterID)
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) || IsNotAccessibleByID(err) {
+			log.Printf("[INFO] Cluster V2 %s not found", clusterID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Restore is a one time action, keeping the values set at creation time
+	return nil
+}
+
+func resourceRancher2ClusterV2ETCDRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	// An etcd snapshot restore can't be reverted, just removing it from tfstate
+	log.Printf("[INFO] Removing Cluster V2 etcd restore %s from tfstate", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func getClusterV2RKEControlPlaneByID(c *Config, id string) (*ClusterV2RKEControlPlane, error) {
+	if c == nil {
+		return nil, fmt.Errorf("Getting cluster V2 RKE control plane: Provider config is nil")
+	}
+	if len(id) == 0 {
+		return nil, fmt.Errorf("Getting cluster V2 RKE control plane: Cluster V2 ID is empty")
+	}
+	resp := &ClusterV2RKEControlPlane{}
+	err := c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, clusterV2RKEControlPlaneAPIType, resp)
+	if err != nil {
+		if !IsServerError(err) && !IsNotFound(err) && !IsForbidden(err) {
+			return nil, fmt.Errorf("Getting cluster V2 RKE control plane: %w", err)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+func getClusterV2Machines(c *Config, cluster *ClusterV2) ([]ClusterV2Machine, error) {
+	if c == nil {
+		return nil, fmt.Errorf("Getting cluster V2 machines: Provider config is nil")
+	}
+	if cluster == nil {
+		return nil, fmt.Errorf("Getting cluster V2 machines: Cluster V2 is nil")
+	}
+	client, err := c.CatalogV2Client(rancher2DefaultLocalClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := map[string]interface{}{
+		"labelSelector": clusterV2MachineClusterNameLabel + "=" + cluster.ObjectMeta.Name,
+	}
+	resp := []ClusterV2Machine{}
+	err = listAllObjects(client, clusterV2MachineAPIType, filters, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("Getting cluster V2 machines: %w", err)
+	}
+
+	machines := []ClusterV2Machine{}
+	for _, machine := range resp {
+		if machine.ObjectMeta.Namespace == cluster.ObjectMeta.Namespace {
+			machines = append(machines, machine)
+		}
+	}
+	return machines, nil
+}
+
+func waitForClusterV2ETCDRestore(c *Config, id string, generation int, interval time.Duration) (*ClusterV2RKEControlPlane, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), interval)
+	defer cancel()
+	for {
+		obj, err := getClusterV2RKEControlPlaneByID(c, id)
+		if err != nil {
+			log.Printf("[DEBUG] Retrying on error Refreshing Cluster V2 RKE control plane %s: %v", id, err)
+			if !IsNotFound(err) && !IsForbidden(err) && !IsNotAccessibleByID(err) {
+				return nil, fmt.Errorf("Getting cluster V2 RKE control plane ID (%s): %w", id, err)
+			}
+		}
+		if obj != nil && obj.Status.ETCDSnapshotRestore != nil && obj.Status.ETCDSnapshotRestore.Generation == generation {
+			switch obj.Status.ETCDSnapshotRestorePhase {
+			case rkev1.ETCDSnapshotPhaseFinished:
+				return obj, nil
+			case rkev1.ETCDSnapshotPhaseFailed:
+				return nil, fmt.Errorf("Cluster V2 ID %s: etcd snapshot restore %s failed", id, obj.Status.ETCDSnapshotRestore.Name)
+			}
+			log.Printf("[DEBUG] Cluster V2 %s etcd snapshot restore phase: %s", id, obj.Status.ETCDSnapshotRestorePhase)
+		}
+		select {
+		case <-time.After(rancher2RetriesWait * time.Second):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Timeout waiting for cluster V2 ID %s etcd snapshot restore", id)
+		}
+	}
+}
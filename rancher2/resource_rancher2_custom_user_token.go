@@ -86,8 +86,11 @@ func resourceRancher2CustomUserTokenRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	renew := d.Get("renew").(bool)
-	if (!*token.Enabled || token.Expired) && renew {
+	renew, err := isTokenRenewRequired(token, d.Get("rotate_before").(string), time.Now())
+	if err != nil {
+		return err
+	}
+	if renew && d.Get("renew").(bool) {
 		d.Set("renew", false)
 	}
 
@@ -104,7 +107,7 @@ func resourceRancher2CustomUserTokenRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceRancher2CustomUserTokenUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceRancher2TokenRead(d, meta)
+	return resourceRancher2CustomUserTokenRead(d, meta)
 }
 
 func resourceRancher2CustomUserTokenDelete(d *schema.ResourceData, meta interface{}) error {
@@ -20,6 +20,17 @@ func resourceRancher2GlobalRole() *schema.Resource {
 		},
 
 		Schema: globalRoleFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+			if d.NewValueKnown("namespaced_rules") {
+				if err := validateGlobalRoleNamespacedRules(d.Get("namespaced_rules").([]interface{})); err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("inherited_cluster_roles") || !d.NewValueKnown("inherited_cluster_roles") {
+				return nil
+			}
+			return validateGlobalRoleInheritedClusterRoles(meta.(*Config), toArrayString(d.Get("inherited_cluster_roles").([]interface{})))
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -104,6 +115,11 @@ func resourceRancher2GlobalRoleUpdate(d *schema.ResourceData, meta interface{})
 			"inheritedClusterRoles": toArrayString(d.Get("inherited_cluster_roles").([]interface{})),
 		}
 
+		// Namespaced rules are supported from Rancher v2.8.0
+		if d.HasChange("namespaced_rules") {
+			update["namespacedRules"] = expandGlobalRoleNamespacedRules(d.Get("namespaced_rules").([]interface{}))
+		}
+
 		if _, err = client.GlobalRole.Update(globalRole, update); err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -145,3 +161,39 @@ func resourceRancher2GlobalRoleDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 }
+
+// validateGlobalRoleInheritedClusterRoles checks that inherited cluster roles are existing cluster context role templates
+func validateGlobalRoleInheritedClusterRoles(c *Config, ids []string) error {
+	for _, id := range ids {
+		roleTemplate, err := c.GetRoleTemplateByID(id)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("[ERROR] inherited_cluster_roles role template %s not found: %v", id, err)
+			}
+			return fmt.Errorf("[ERROR] Getting inherited_cluster_roles role template %s: %v", id, err)
+		}
+		if roleTemplate.Context != roleTemplateContextCluster {
+			return fmt.Errorf("[ERROR] inherited_cluster_roles role template %s context is %q, it should be %q", id, roleTemplate.Context, roleTemplateContextCluster)
+		}
+	}
+
+	return nil
+}
+
+// validateGlobalRoleNamespacedRules checks that every namespace is set just once
+func validateGlobalRoleNamespacedRules(p []interface{}) error {
+	namespaces := map[string]bool{}
+	for i := range p {
+		in, ok := p[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		namespace, _ := in["namespace"].(string)
+		if namespaces[namespace] {
+			return fmt.Errorf("[ERROR] namespaced_rules namespace %q is duplicated, rules should be set at one namespaced_rules block", namespace)
+		}
+		namespaces[namespace] = true
+	}
+
+	return nil
+}
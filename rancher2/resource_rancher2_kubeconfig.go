@@ -0,0 +1,247 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func resourceRancher2Kubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2KubeconfigCreate,
+		Read:   resourceRancher2KubeconfigRead,
+		Update: resourceRancher2KubeconfigUpdate,
+		Delete: resourceRancher2KubeconfigDelete,
+
+		Schema: kubeconfigFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			if d.Id() != "" && d.HasChange("renew") && d.Get("renew").(bool) {
+				for _, key := range []string{"kube_config", "token_ids", "expires_at"} {
+					if err := d.SetNewComputed(key); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2KubeconfigCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Creating Kubeconfig")
+
+	clusterIDs, err := generateKubeconfig(d, meta.(*Config))
+	if err != nil {
+		return err
+	}
+	d.SetId(strings.Join(clusterIDs, ","))
+
+	return resourceRancher2KubeconfigRead(d, meta)
+}
+
+func resourceRancher2KubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Kubeconfig ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	renew := d.Get("renew").(bool)
+	expiresAt := ""
+	for _, id := range toArrayString(d.Get("token_ids").([]interface{})) {
+		token, err := client.Token.ByID(id)
+		if err != nil {
+			if !IsNotFound(err) && !IsForbidden(err) {
+				return err
+			}
+			log.Printf("[INFO] Kubeconfig token ID %s not found.", id)
+			if renew {
+				d.Set("renew", false)
+			}
+			continue
+		}
+		renewRequired, err := isTokenRenewRequired(token, d.Get("rotate_before").(string), time.Now())
+		if err != nil {
+			return err
+		}
+		if renewRequired && renew {
+			d.Set("renew", false)
+		}
+		if len(token.ExpiresAt) > 0 && (len(expiresAt) == 0 || token.ExpiresAt < expiresAt) {
+			expiresAt = token.ExpiresAt
+		}
+	}
+	d.Set("expires_at", expiresAt)
+
+	return nil
+}
+
+func resourceRancher2KubeconfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("renew") && d.Get("renew").(bool) {
+		log.Printf("[INFO] Rotating Kubeconfig ID %s tokens", d.Id())
+
+		// Generating the new kubeconfig before removing the tokens it replaces
+		oldTokenIDs, _ := d.GetChange("token_ids")
+		_, err := generateKubeconfig(d, meta.(*Config))
+		if err != nil {
+			return err
+		}
+		err = deleteKubeconfigTokens(meta.(*Config), toArrayString(oldTokenIDs.([]interface{})))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRancher2KubeconfigRead(d, meta)
+}
+
+func resourceRancher2KubeconfigDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Kubeconfig ID %s", d.Id())
+
+	err := deleteKubeconfigTokens(meta.(*Config), toArrayString(d.Get("token_ids").([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// generateKubeconfig creates a token for every cluster and sets the kubeconfig using them. It returns the cluster V1 IDs
+func generateKubeconfig(d *schema.ResourceData, c *Config) ([]string, error) {
+	patch, err := c.IsRancherVersionGreaterThanOrEqualAndLessThan(rancher2TokeTTLMinutesVersion, rancher2TokeTTLMilisVersion)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ace := d.Get("ace").(bool)
+	ttl := d.Get("ttl").(int)
+	clusterIDs := []string{}
+	clusters := []kubeconfigCluster{}
+	tokenIDs := []string{}
+	for _, id := range toArrayString(d.Get("cluster_ids").([]interface{})) {
+		cluster, err := getKubeconfigCluster(c, id, ace)
+		if err != nil {
+			deleteKubeconfigTokens(c, tokenIDs)
+			return nil, err
+		}
+
+		token := &managementClient.Token{
+			ClusterID:   cluster.ID,
+			Description: kubeconfigTokenDescPrefix + cluster.ID,
+		}
+		if ttl > 0 {
+			token.TTLMillis = expandTokenTTL(ttl, patch)
+		}
+		newToken, err := client.Token.Create(token)
+		if err != nil {
+			deleteKubeconfigTokens(c, tokenIDs)
+			return nil, fmt.Errorf("[ERROR] Creating kubeconfig token for cluster %s: %v", cluster.ID, err)
+		}
+		tokenIDs = append(tokenIDs, newToken.ID)
+		cluster.Token = newToken.Token
+
+		clusterIDs = append(clusterIDs, cluster.ID)
+		clusters = append(clusters, *cluster)
+	}
+
+	obj, err := flattenKubeconfig(c.URL, c.CACerts, ace, clusters)
+	if err != nil {
+		deleteKubeconfigTokens(c, tokenIDs)
+		return nil, err
+	}
+	config, err := getKubeConfigFromObj(obj)
+	if err != nil {
+		deleteKubeconfigTokens(c, tokenIDs)
+		return nil, err
+	}
+
+	d.Set("kube_config", config)
+	d.Set("token_ids", toArrayInterface(tokenIDs))
+	d.Set("renew", true)
+
+	return clusterIDs, nil
+}
+
+func getKubeconfigCluster(c *Config, id string, ace bool) (*kubeconfigCluster, error) {
+	clusterID, err := getClusterV1IDFromClusterID(c, id)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := c.GetClusterByID(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Getting cluster %s: %v", clusterID, err)
+	}
+
+	obj := &kubeconfigCluster{
+		ID:   cluster.ID,
+		Name: cluster.Name,
+	}
+	if !ace || cluster.LocalClusterAuthEndpoint == nil || !cluster.LocalClusterAuthEndpoint.Enabled {
+		return obj, nil
+	}
+
+	obj.FQDN = cluster.LocalClusterAuthEndpoint.FQDN
+	obj.FQDNCACerts = cluster.LocalClusterAuthEndpoint.CACerts
+	obj.NodesCACert = cluster.CACert
+	nodes, err := c.GetClusterNodes(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Getting cluster %s nodes: %v", clusterID, err)
+	}
+	for _, node := range nodes {
+		if !node.ControlPlane {
+			continue
+		}
+		kubeNode := kubeconfigNode{
+			Name:    node.NodeName,
+			Address: node.ExternalIPAddress,
+		}
+		if len(kubeNode.Name) == 0 {
+			kubeNode.Name = node.Hostname
+		}
+		if len(kubeNode.Address) == 0 {
+			kubeNode.Address = node.IPAddress
+		}
+		if len(kubeNode.Address) > 0 {
+			obj.Nodes = append(obj.Nodes, kubeNode)
+		}
+	}
+
+	return obj, nil
+}
+
+func deleteKubeconfigTokens(c *Config, ids []string) error {
+	client, err := c.ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		token, err := client.Token.ByID(id)
+		if err != nil {
+			if IsNotFound(err) || IsForbidden(err) {
+				continue
+			}
+			return err
+		}
+		err = client.Token.Delete(token)
+		if err != nil && !IsNotFound(err) {
+			return fmt.Errorf("[ERROR] Error removing kubeconfig token %s: %v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -18,6 +18,12 @@ func resourceRancher2MachineConfigV2() *schema.Resource {
 		Update: resourceRancher2MachineConfigV2Update,
 		Delete: resourceRancher2MachineConfigV2Delete,
 		Schema: machineConfigV2Fields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			getSchema := func(driver string) (*norman.Schema, error) {
+				return getMachineConfigV2GenericSchema(i.(*Config), driver)
+			}
+			return validateDriverConfig(d, "generic_config", getSchema, machineConfigV2GenericReservedFields)
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -206,6 +212,13 @@ func createMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.ID = resp.ID
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2HetznerKind:
+		resp := &MachineConfigV2Hetzner{}
+		err = c.createObjectV2(rancher2DefaultLocalClusterID, machineConfigV2HetznerAPIType, obj.HetznerConfig, resp)
+		out.HetznerConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2LinodeKind:
 		resp := &MachineConfigV2Linode{}
 		err = c.createObjectV2(rancher2DefaultLocalClusterID, machineConfigV2LinodeAPIType, obj.LinodeConfig, resp)
@@ -213,6 +226,13 @@ func createMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.ID = resp.ID
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2OpennebulaKind:
+		resp := &MachineConfigV2Opennebula{}
+		err = c.createObjectV2(rancher2DefaultLocalClusterID, machineConfigV2OpennebulaAPIType, obj.OpennebulaConfig, resp)
+		out.OpennebulaConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2OpenstackKind:
 		resp := &MachineConfigV2Openstack{}
 		err = c.createObjectV2(rancher2DefaultLocalClusterID, machineConfigV2OpenstackAPIType, obj.OpenstackConfig, resp)
@@ -220,6 +240,13 @@ func createMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.ID = resp.ID
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2OutscaleKind:
+		resp := &MachineConfigV2Outscale{}
+		err = c.createObjectV2(rancher2DefaultLocalClusterID, machineConfigV2OutscaleAPIType, obj.OutscaleConfig, resp)
+		out.OutscaleConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2VmwarevsphereKind:
 		resp := &MachineConfigV2Vmwarevsphere{}
 		err = c.createObjectV2(rancher2DefaultLocalClusterID, machineConfigV2VmwarevsphereAPIType, obj.VmwarevsphereConfig, resp)
@@ -228,7 +255,19 @@ func createMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
 	default:
-		return nil, fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		if obj.GenericConfig == nil {
+			return nil, fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		}
+		err = expandMachineConfigV2GenericWithSchema(c, obj.GenericConfig)
+		if err != nil {
+			return nil, err
+		}
+		resp := &MachineConfigV2Generic{}
+		err = c.createObjectV2(rancher2DefaultLocalClusterID, getMachineConfigV2GenericAPIType(obj.GenericConfig.Driver), obj.GenericConfig, resp)
+		out.GenericConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	}
 	if err != nil {
 		return nil, fmt.Errorf("Creating Machine Config V2: %s", err)
@@ -302,6 +341,16 @@ func getMachineConfigV2ByID(c *Config, id, kind string) (*MachineConfigV2, error
 		out.Type = resp.Type
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2HetznerKind:
+		resp := &MachineConfigV2Hetzner{}
+		err = c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, machineConfigV2HetznerAPIType, resp)
+		out.HetznerConfig = resp
+		out.ID = resp.ID
+		out.Links = resp.Links
+		out.Actions = resp.Actions
+		out.Type = resp.Type
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2LinodeKind:
 		resp := &MachineConfigV2Linode{}
 		err = c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, machineConfigV2LinodeAPIType, resp)
@@ -312,6 +361,16 @@ func getMachineConfigV2ByID(c *Config, id, kind string) (*MachineConfigV2, error
 		out.Type = resp.Type
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2OpennebulaKind:
+		resp := &MachineConfigV2Opennebula{}
+		err = c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, machineConfigV2OpennebulaAPIType, resp)
+		out.OpennebulaConfig = resp
+		out.ID = resp.ID
+		out.Links = resp.Links
+		out.Actions = resp.Actions
+		out.Type = resp.Type
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2OpenstackKind:
 		resp := &MachineConfigV2Openstack{}
 		err = c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, machineConfigV2OpenstackAPIType, resp)
@@ -322,6 +381,16 @@ func getMachineConfigV2ByID(c *Config, id, kind string) (*MachineConfigV2, error
 		out.Type = resp.Type
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2OutscaleKind:
+		resp := &MachineConfigV2Outscale{}
+		err = c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, machineConfigV2OutscaleAPIType, resp)
+		out.OutscaleConfig = resp
+		out.ID = resp.ID
+		out.Links = resp.Links
+		out.Actions = resp.Actions
+		out.Type = resp.Type
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2VmwarevsphereKind:
 		resp := &MachineConfigV2Vmwarevsphere{}
 		err = c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, machineConfigV2VmwarevsphereAPIType, resp)
@@ -333,7 +402,18 @@ func getMachineConfigV2ByID(c *Config, id, kind string) (*MachineConfigV2, error
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
 	default:
-		return nil, fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		if len(kind) == 0 {
+			return nil, fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		}
+		resp := &MachineConfigV2Generic{}
+		err = c.getObjectV2ByID(rancher2DefaultLocalClusterID, id, getMachineConfigV2GenericAPIType(getMachineConfigV2GenericDriver(kind)), resp)
+		out.GenericConfig = resp
+		out.ID = resp.ID
+		out.Links = resp.Links
+		out.Actions = resp.Actions
+		out.Type = resp.Type
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	}
 	if err != nil {
 		if !IsServerError(err) && !IsNotFound(err) && !IsForbidden(err) {
@@ -383,6 +463,13 @@ func updateMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.ID = resp.ID
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2HetznerKind:
+		resp := &MachineConfigV2Hetzner{}
+		err = c.updateObjectV2(rancher2DefaultLocalClusterID, obj.ID, machineConfigV2HetznerAPIType, obj.HetznerConfig, resp)
+		out.HetznerConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2LinodeKind:
 		resp := &MachineConfigV2Linode{}
 		err = c.updateObjectV2(rancher2DefaultLocalClusterID, obj.ID, machineConfigV2LinodeAPIType, obj.LinodeConfig, resp)
@@ -390,6 +477,13 @@ func updateMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.ID = resp.ID
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2OpennebulaKind:
+		resp := &MachineConfigV2Opennebula{}
+		err = c.updateObjectV2(rancher2DefaultLocalClusterID, obj.ID, machineConfigV2OpennebulaAPIType, obj.OpennebulaConfig, resp)
+		out.OpennebulaConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2OpenstackKind:
 		resp := &MachineConfigV2Openstack{}
 		err = c.updateObjectV2(rancher2DefaultLocalClusterID, obj.ID, machineConfigV2OpenstackAPIType, obj.OpenstackConfig, resp)
@@ -397,6 +491,13 @@ func updateMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.ID = resp.ID
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
+	case machineConfigV2OutscaleKind:
+		resp := &MachineConfigV2Outscale{}
+		err = c.updateObjectV2(rancher2DefaultLocalClusterID, obj.ID, machineConfigV2OutscaleAPIType, obj.OutscaleConfig, resp)
+		out.OutscaleConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	case machineConfigV2VmwarevsphereKind:
 		resp := &MachineConfigV2Vmwarevsphere{}
 		err = c.updateObjectV2(rancher2DefaultLocalClusterID, obj.ID, machineConfigV2VmwarevsphereAPIType, obj.VmwarevsphereConfig, resp)
@@ -405,10 +506,54 @@ func updateMachineConfigV2(c *Config, obj *MachineConfigV2) (*MachineConfigV2, e
 		out.TypeMeta = resp.TypeMeta
 		out.ObjectMeta = resp.ObjectMeta
 	default:
-		return nil, fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		if obj.GenericConfig == nil {
+			return nil, fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		}
+		err = expandMachineConfigV2GenericWithSchema(c, obj.GenericConfig)
+		if err != nil {
+			return nil, err
+		}
+		resp := &MachineConfigV2Generic{}
+		err = c.updateObjectV2(rancher2DefaultLocalClusterID, obj.ID, getMachineConfigV2GenericAPIType(obj.GenericConfig.Driver), obj.GenericConfig, resp)
+		out.GenericConfig = resp
+		out.ID = resp.ID
+		out.TypeMeta = resp.TypeMeta
+		out.ObjectMeta = resp.ObjectMeta
 	}
 	if err != nil {
 		return nil, fmt.Errorf("Updating Machine Config V2: %s", err)
 	}
 	return out, err
 }
+
+// getMachineConfigV2GenericSchema returns the steve schema of the driver machine config
+func getMachineConfigV2GenericSchema(c *Config, driver string) (*norman.Schema, error) {
+	if c == nil {
+		return nil, fmt.Errorf("Getting Machine Config V2 schema: Provider config is nil")
+	}
+	if len(driver) == 0 {
+		return nil, fmt.Errorf("Getting Machine Config V2 schema: driver is empty")
+	}
+
+	client, err := c.CatalogV2Client(rancher2DefaultLocalClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiType := getMachineConfigV2GenericAPIType(driver)
+	s, err := getClientSchemaByID(client, apiType)
+	if err != nil {
+		return nil, fmt.Errorf("Getting Machine Config V2 schema %s, is %s node driver active?: %v", apiType, driver, err)
+	}
+
+	return s, nil
+}
+
+func expandMachineConfigV2GenericWithSchema(c *Config, obj *MachineConfigV2Generic) error {
+	s, err := getMachineConfigV2GenericSchema(c, obj.Driver)
+	if err != nil {
+		return err
+	}
+
+	return expandMachineConfigV2GenericFields(obj, s)
+}
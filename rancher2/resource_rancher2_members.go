@@ -0,0 +1,144 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// readRoleTemplateBindingMembers sets the members managed by the resource, as defined at config if matching
+func readRoleTemplateBindingMembers(d *schema.ResourceData, c *Config, scope *roleTemplateBindingMembersScope) error {
+	members, err := getRoleTemplateBindingMembers(d, c, scope)
+	if err != nil {
+		return err
+	}
+	config, err := expandRoleTemplateBindingMembers(d.Get("member").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	return d.Set("member", flattenRoleTemplateBindingMembers(members, config))
+}
+
+// getRoleTemplateBindingMembers returns the role template bindings of the scope managed by the resource
+func getRoleTemplateBindingMembers(d *schema.ResourceData, c *Config, scope *roleTemplateBindingMembersScope) ([]roleTemplateBindingMember, error) {
+	members, err := scope.List(c, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	config, err := expandRoleTemplateBindingMembers(d.Get("member").(*schema.Set).List())
+	if err != nil {
+		return nil, err
+	}
+
+	return filterRoleTemplateBindingMembers(members, config, expandRoleTemplateBindingMembersExclusion(d), scope.OwnerRoleTemplateID), nil
+}
+
+// updateRoleTemplateBindingMembers adds missing members before removing unmanaged ones, to not lose access in the middle
+func updateRoleTemplateBindingMembers(d *schema.ResourceData, c *Config, scope *roleTemplateBindingMembersScope, timeout time.Duration) error {
+	client, err := c.ManagementClient()
+	if err != nil {
+		return err
+	}
+	members, err := getRoleTemplateBindingMembers(d, c, scope)
+	if err != nil {
+		return err
+	}
+	config, err := expandRoleTemplateBindingMembers(d.Get("member").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	add, remove := diffRoleTemplateBindingMembers(members, config)
+	for _, member := range add {
+		err = c.RoleTemplateExist(member.RoleTemplateID)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Adding %s members %s role template %s binding", scope.Name, d.Id(), member.RoleTemplateID)
+		id, err := scope.Create(client, d.Id(), member)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Adding %s %s member: %v", scope.Name, d.Id(), err)
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"active"},
+			Target:     []string{"active"},
+			Refresh:    scope.Refresh(client, id),
+			Timeout:    timeout,
+			Delay:      1 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, waitErr := stateConf.WaitForState()
+		if waitErr != nil {
+			return fmt.Errorf("[ERROR] waiting for %s role template binding (%s) to be created: %s", scope.Name, id, waitErr)
+		}
+	}
+	for _, member := range remove {
+		log.Printf("[INFO] Removing %s members %s unmanaged binding %s", scope.Name, d.Id(), member.BindingID)
+		err = deleteRoleTemplateBindingMember(c, scope, member.BindingID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteRoleTemplateBindingMembers removes just the members managed by the resource and defined at config
+func deleteRoleTemplateBindingMembers(d *schema.ResourceData, c *Config, scope *roleTemplateBindingMembersScope, timeout time.Duration) error {
+	members, err := getRoleTemplateBindingMembers(d, c, scope)
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			return nil
+		}
+		return err
+	}
+	config, err := expandRoleTemplateBindingMembers(d.Get("member").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		if !isRoleTemplateBindingMemberIn(member, config) {
+			continue
+		}
+		err = deleteRoleTemplateBindingMember(c, scope, member.BindingID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteRoleTemplateBindingMember(c *Config, scope *roleTemplateBindingMembersScope, id string, timeout time.Duration) error {
+	client, err := c.ManagementClient()
+	if err != nil {
+		return err
+	}
+	err = scope.Delete(client, id)
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Removing %s role template binding %s: %v", scope.Name, id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    scope.Refresh(client, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for %s role template binding (%s) to be removed: %s", scope.Name, id, waitErr)
+	}
+
+	return nil
+}
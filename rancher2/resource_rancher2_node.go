@@ -0,0 +1,268 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func resourceRancher2Node() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2NodeCreate,
+		Read:   resourceRancher2NodeRead,
+		Update: resourceRancher2NodeUpdate,
+		Delete: resourceRancher2NodeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2NodeImport,
+		},
+
+		Schema: nodeFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			if d.Get("drained").(bool) && !d.Get("cordoned").(bool) {
+				return fmt.Errorf("[ERROR] drained node must be cordoned, set cordoned to true")
+			}
+			return nil
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2NodeCreate(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+	nodeName := d.Get("node_name").(string)
+
+	log.Printf("[INFO] Creating Node %s on cluster %s", nodeName, clusterID)
+
+	err := meta.(*Config).ClusterExist(clusterID)
+	if err != nil {
+		return err
+	}
+
+	node, err := getNodeByName(meta.(*Config), clusterID, nodeName)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(node.ID)
+
+	err = updateNode(d, meta, node, map[string]interface{}{}, map[string]interface{}{}, []interface{}{}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2NodeRead(d, meta)
+}
+
+func resourceRancher2NodeRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Node ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	node, err := client.Node.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			log.Printf("[INFO] Node ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenNode(d, node)
+}
+
+func resourceRancher2NodeUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Node ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	node, err := client.Node.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	oldAnnotations, _ := d.GetChange("annotations")
+	oldLabels, _ := d.GetChange("labels")
+	oldTaints, _ := d.GetChange("taints")
+	err = updateNode(d, meta, node, oldAnnotations.(map[string]interface{}), oldLabels.(map[string]interface{}), oldTaints.([]interface{}), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2NodeRead(d, meta)
+}
+
+func resourceRancher2NodeDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Node ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	node, err := client.Node.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			log.Printf("[INFO] Node ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Removing Terraform managed metadata and maintenance state, the node itself is kept
+	update := map[string]interface{}{
+		"annotations": expandNodeOwnedMap(node.Annotations, d.Get("annotations").(map[string]interface{}), map[string]interface{}{}),
+		"labels":      expandNodeOwnedMap(node.Labels, d.Get("labels").(map[string]interface{}), map[string]interface{}{}),
+		"taints":      expandNodeOwnedTaints(node.Taints, d.Get("taints").([]interface{}), []interface{}{}),
+	}
+	node, err = client.Node.Update(node, update)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Removing node %s metadata: %v", d.Id(), err)
+	}
+
+	if d.Get("cordoned").(bool) && (node.Unschedulable || node.State == nodeStateDrained) {
+		err = uncordonNode(client, node, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateNode sets the Terraform managed node metadata, replacing the old managed values, and applies
+// the cordoned and drained state waiting for completion
+func updateNode(d *schema.ResourceData, meta interface{}, node *managementClient.Node, oldAnnotations, oldLabels map[string]interface{}, oldTaints []interface{}, timeout time.Duration) error {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("annotations") || d.HasChange("labels") || d.HasChange("taints") {
+		update := map[string]interface{}{
+			"annotations": expandNodeOwnedMap(node.Annotations, oldAnnotations, d.Get("annotations").(map[string]interface{})),
+			"labels":      expandNodeOwnedMap(node.Labels, oldLabels, d.Get("labels").(map[string]interface{})),
+			"taints":      expandNodeOwnedTaints(node.Taints, oldTaints, d.Get("taints").([]interface{})),
+		}
+		node, err = client.Node.Update(node, update)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Updating node %s metadata: %v", d.Id(), err)
+		}
+	}
+
+	cordoned := d.Get("cordoned").(bool)
+	drained := d.Get("drained").(bool)
+
+	if (node.State == nodeStateDrained && !drained) || (node.Unschedulable && !cordoned) {
+		err = uncordonNode(client, node, timeout)
+		if err != nil {
+			return err
+		}
+		node, err = client.Node.ByID(node.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if drained && node.State != nodeStateDrained {
+		log.Printf("[INFO] Draining Node ID %s", node.ID)
+		err = client.Node.ActionDrain(node, expandNodeDrainInput(d.Get("drain_input").([]interface{})))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Draining node %s: %v", node.ID, err)
+		}
+		return waitForNodeState(client, node.ID, nodeStateDrained, []string{nodeStateActive, nodeStateCordoned, nodeStateDraining}, timeout)
+	}
+
+	if cordoned && !node.Unschedulable {
+		log.Printf("[INFO] Cordoning Node ID %s", node.ID)
+		err = client.Node.ActionCordon(node)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Cordoning node %s: %v", node.ID, err)
+		}
+		return waitForNodeState(client, node.ID, nodeStateCordoned, []string{nodeStateActive}, timeout)
+	}
+
+	return nil
+}
+
+func uncordonNode(client *managementClient.Client, node *managementClient.Node, timeout time.Duration) error {
+	log.Printf("[INFO] Uncordoning Node ID %s", node.ID)
+
+	if node.State == nodeStateDraining {
+		err := client.Node.ActionStopDrain(node)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Stopping node %s drain: %v", node.ID, err)
+		}
+	}
+	err := client.Node.ActionUncordon(node)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Uncordoning node %s: %v", node.ID, err)
+	}
+
+	return waitForNodeState(client, node.ID, nodeStateActive, []string{nodeStateCordoned, nodeStateDraining, nodeStateDrained}, timeout)
+}
+
+func waitForNodeState(client *managementClient.Client, id, target string, pending []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    nodeStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for node (%s) to be %s: %s", id, target, waitErr)
+	}
+
+	return nil
+}
+
+// getNodeByName returns the cluster node matching name by node name or hostname
+func getNodeByName(c *Config, clusterID, name string) (*managementClient.Node, error) {
+	nodes, err := c.GetClusterNodes(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range nodes {
+		if nodes[i].NodeName == name || (len(nodes[i].NodeName) == 0 && nodes[i].Hostname == name) {
+			return &nodes[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("[ERROR] node %s not found on cluster %s", name, clusterID)
+}
+
+// nodeStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Node.
+func nodeStateRefreshFunc(client *managementClient.Client, nodeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.Node.ByID(nodeID)
+		if err != nil {
+			if IsNotFound(err) || IsForbidden(err) {
+				return obj, nodeStateRemoved, nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Transitioning == "error" {
+			return obj, obj.State, fmt.Errorf("%s", obj.TransitioningMessage)
+		}
+
+		return obj, obj.State, nil
+	}
+}
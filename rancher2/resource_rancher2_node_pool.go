@@ -21,6 +21,13 @@ func resourceRancher2NodePool() *schema.Resource {
 		},
 
 		Schema: nodePoolFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			rollingReplace := expandNodePoolRollingReplace(d.Get("rolling_replace").([]interface{}))
+			if rollingReplace != nil && rollingReplace.MaxSurge+rollingReplace.MaxUnavailable == 0 {
+				return fmt.Errorf("[ERROR] rolling_replace max_surge and max_unavailable can't be both 0")
+			}
+			return nil
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -89,6 +96,18 @@ func resourceRancher2NodePoolRead(d *schema.ResourceData, meta interface{}) erro
 			return resource.NonRetryableError(err)
 		}
 
+		// Keeping node_template_id diff while a rolling replace is pending, so next apply resumes it
+		if len(d.Get("rolling_replace").([]interface{})) > 0 {
+			nodes, err := getNodePoolNodes(meta.(*Config), nodePool)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if old, _ := splitNodePoolNodes(nodes, nodePool.NodeTemplateID); len(old) > 0 {
+				log.Printf("[INFO] Node Pool ID %s has %d nodes pending to be replaced", d.Id(), len(old))
+				d.Set("node_template_id", old[0].NodeTemplateID)
+			}
+		}
+
 		return nil
 	})
 }
@@ -119,6 +138,14 @@ func resourceRancher2NodePoolUpdate(d *schema.ResourceData, meta interface{}) er
 		"labels":                  toMapString(d.Get("labels").(map[string]interface{})),
 	}
 
+	var rollingReplace *nodePoolRollingReplace
+	if d.HasChange("node_template_id") {
+		rollingReplace = expandNodePoolRollingReplace(d.Get("rolling_replace").([]interface{}))
+	}
+	if rollingReplace != nil {
+		update["quantity"] = int64(d.Get("quantity").(int) + rollingReplace.MaxSurge)
+	}
+
 	newNodePool, err := client.NodePool.Update(nodePool, update)
 	if err != nil {
 		return err
@@ -138,6 +165,13 @@ func resourceRancher2NodePoolUpdate(d *schema.ResourceData, meta interface{}) er
 			"[ERROR] waiting for node pool (%s) to be updated: %s", newNodePool.ID, waitErr)
 	}
 
+	if rollingReplace != nil {
+		err = nodePoolRollingReplaceNodes(meta.(*Config), newNodePool, rollingReplace, d.Get("quantity").(int), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceRancher2NodePoolRead(d, meta)
 }
 
@@ -199,3 +233,147 @@ func nodePoolStateRefreshFunc(client *managementClient.Client, nodePoolID string
 		return obj, obj.State, nil
 	}
 }
+
+// nodePoolRollingReplaceNodes replaces the pool nodes not using the pool node template, by batches of up to
+// max_surge + max_unavailable nodes. Every batch waits for the previous replacements to be active, then drains
+// and deletes the old nodes, letting the node pool create the new ones. Replacement stops on the first failure
+func nodePoolRollingReplaceNodes(c *Config, nodePool *managementClient.NodePool, rollingReplace *nodePoolRollingReplace, quantity int, timeout time.Duration) error {
+	client, err := c.ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	replaced := 0
+	total := -1
+	for {
+		var batch []managementClient.Node
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"waiting"},
+			Target:  []string{"ready", "done"},
+			Refresh: func() (interface{}, string, error) {
+				nodes, err := getNodePoolNodes(c, nodePool)
+				if err != nil {
+					return nil, "", err
+				}
+				for _, node := range nodes {
+					if node.NodeTemplateID == nodePool.NodeTemplateID && node.Transitioning == "error" {
+						return nil, "", fmt.Errorf("node %s failed: %s", node.ID, node.TransitioningMessage)
+					}
+				}
+				old, active := splitNodePoolNodes(nodes, nodePool.NodeTemplateID)
+				if total < 0 {
+					total = len(old)
+				}
+				if len(old) == 0 {
+					return nodes, "done", nil
+				}
+				size := rollingReplace.batchSize(quantity, active, len(old))
+				if size == 0 {
+					return nodes, "waiting", nil
+				}
+				batch = old[:size]
+				return nodes, "ready", nil
+			},
+			Timeout:    time.Until(deadline),
+			Delay:      1 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, waitErr := stateConf.WaitForState()
+		if waitErr != nil {
+			return fmt.Errorf("[ERROR] waiting for node pool (%s) new nodes to be active: %s", nodePool.ID, waitErr)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for i := range batch {
+			err = nodePoolReplaceNode(client, &batch[i], rollingReplace, time.Until(deadline))
+			if err != nil {
+				return fmt.Errorf("[ERROR] Node pool (%s) rolling replace stopped after %d/%d nodes: %v", nodePool.ID, replaced, total, err)
+			}
+			replaced++
+			log.Printf("[INFO] Node Pool ID %s rolling replace: %d/%d nodes replaced", nodePool.ID, replaced, total)
+		}
+	}
+
+	if rollingReplace.MaxSurge == 0 {
+		return nil
+	}
+
+	// Removing surge nodes
+	nodePool, err = client.NodePool.ByID(nodePool.ID)
+	if err != nil {
+		return err
+	}
+	nodePool, err = client.NodePool.Update(nodePool, map[string]interface{}{"quantity": int64(quantity)})
+	if err != nil {
+		return err
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    nodePoolStateRefreshFunc(client, nodePool.ID),
+		Timeout:    time.Until(deadline),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for node pool (%s) to be scaled down: %s", nodePool.ID, waitErr)
+	}
+
+	return nil
+}
+
+// nodePoolReplaceNode drains, if required, and deletes the node, waiting for it to be removed
+func nodePoolReplaceNode(client *managementClient.Client, node *managementClient.Node, rollingReplace *nodePoolRollingReplace, timeout time.Duration) error {
+	if rollingReplace.Drain && (node.State == nodeStateActive || node.State == nodeStateCordoned) {
+		log.Printf("[INFO] Draining Node ID %s", node.ID)
+		err := client.Node.ActionDrain(node, rollingReplace.DrainInput)
+		if err != nil {
+			return fmt.Errorf("draining node %s: %v", node.ID, err)
+		}
+		err = waitForNodeState(client, node.ID, nodeStateDrained, []string{nodeStateActive, nodeStateCordoned, nodeStateDraining}, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Deleting Node ID %s", node.ID)
+	err := client.Node.Delete(node)
+	if err != nil && !IsNotFound(err) {
+		return fmt.Errorf("deleting node %s: %v", node.ID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{nodeStateActive, nodeStateCordoned, nodeStateDraining, nodeStateDrained, nodeStateRemoving, "unavailable"},
+		Target:     []string{nodeStateRemoved},
+		Refresh:    nodeStateRefreshFunc(client, node.ID),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("waiting for node %s to be removed: %s", node.ID, waitErr)
+	}
+
+	return nil
+}
+
+func getNodePoolNodes(c *Config, nodePool *managementClient.NodePool) ([]managementClient.Node, error) {
+	nodes, err := c.GetClusterNodes(nodePool.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []managementClient.Node{}
+	for _, node := range nodes {
+		if node.NodePoolID == nodePool.ID {
+			out = append(out, node)
+		}
+	}
+
+	return out, nil
+}
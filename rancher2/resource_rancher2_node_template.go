@@ -23,6 +23,12 @@ func resourceRancher2NodeTemplate() *schema.Resource {
 		},
 
 		Schema: nodeTemplateFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			getSchema := func(driver string) (*norman.Schema, error) {
+				return getNodeTemplateDriverConfigSchema(i.(*Config), driver)
+			}
+			return validateDriverConfig(d, "driver_config", getSchema, nil)
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -42,12 +48,29 @@ func resourceRancher2NodeTemplateCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	driverID := d.Get("driver_id").(string)
+	driverConfig, driverConfigOk := d.Get("driver_config").([]interface{})
+	driverConfigOk = driverConfigOk && len(driverConfig) > 0
+	// Custom node drivers IDs are not their names
+	if driverConfigOk && driverID == nodeTemplate.Driver {
+		driverID, err = meta.(*Config).GetNodeDriverIDByName(nodeTemplate.Driver)
+		if err != nil {
+			return err
+		}
+		d.Set("driver_id", driverID)
+	}
 
 	err = meta.(*Config).activateNodeDriver(driverID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
+	if driverConfigOk {
+		nodeTemplate.DriverConfig, err = expandNodeTemplateDriverConfigWithSchema(meta.(*Config), driverConfig)
+		if err != nil {
+			return err
+		}
+	}
+
 	newNodeTemplate := &NodeTemplate{}
 
 	err = client.APIBaseClient.Create(managementClient.NodeTemplateType, nodeTemplate, newNodeTemplate)
@@ -158,6 +181,13 @@ func resourceRancher2NodeTemplateUpdate(d *schema.ResourceData, meta interface{}
 		update["vmwarevsphereConfig"] = expandVsphereConfig(d.Get("vsphere_config").([]interface{}))
 	case outscaleConfigDriver:
 		update["outscaleConfig"] = expandOutscaleConfig(d.Get("outscale_config").([]interface{}))
+	default:
+		if v, ok := d.Get("driver_config").([]interface{}); ok && len(v) > 0 {
+			update[driver+nodeTemplateDriverConfigSuffix], err = expandNodeTemplateDriverConfigWithSchema(meta.(*Config), v)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	newNodeTemplate := &NodeTemplate{}
@@ -254,3 +284,34 @@ func nodeTemplateStateRefreshFunc(client *managementClient.Client, nodePoolID st
 		return obj, obj.State, nil
 	}
 }
+
+// getNodeTemplateDriverConfigSchema returns the <driver>Config dynamic schema, available if the node driver is active
+func getNodeTemplateDriverConfigSchema(c *Config, driver string) (*norman.Schema, error) {
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+
+	id := driver + nodeTemplateDriverConfigSuffix
+	s, err := getClientSchemaByID(&client.APIBaseClient, id)
+	if err != nil {
+		return nil, fmt.Errorf("Getting node template driver config schema %s, is %s node driver active?: %v", id, driver, err)
+	}
+
+	return s, nil
+}
+
+// expandNodeTemplateDriverConfigWithSchema returns driver_config fields validated against the driver config schema
+func expandNodeTemplateDriverConfigWithSchema(c *Config, p []interface{}) (map[string]interface{}, error) {
+	driver, fields, sensitiveFields := expandDriverConfig(p)
+	s, err := getNodeTemplateDriverConfigSchema(c, driver)
+	if err != nil {
+		return nil, err
+	}
+	err = expandDriverConfigFields(driver, fields, sensitiveFields, s, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeDriverConfigFields(fields, sensitiveFields), nil
+}
@@ -0,0 +1,147 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func resourceRancher2ProjectMembers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ProjectMembersCreate,
+		Read:   resourceRancher2ProjectMembersRead,
+		Update: resourceRancher2ProjectMembersUpdate,
+		Delete: resourceRancher2ProjectMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2ProjectMembersImport,
+		},
+
+		Schema: projectMembersFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ProjectMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	projectID := d.Get("project_id").(string)
+
+	err := meta.(*Config).ProjectExist(projectID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Project Members %s", projectID)
+
+	d.SetId(projectID)
+	err = updateRoleTemplateBindingMembers(d, meta.(*Config), projectMembersScope, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2ProjectMembersRead(d, meta)
+}
+
+func resourceRancher2ProjectMembersRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Project Members ID %s", d.Id())
+
+	_, err := meta.(*Config).GetProjectByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) || IsForbidden(err) {
+			log.Printf("[INFO] Project ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project_id", d.Id())
+	return readRoleTemplateBindingMembers(d, meta.(*Config), projectMembersScope)
+}
+
+func resourceRancher2ProjectMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Project Members ID %s", d.Id())
+
+	err := updateRoleTemplateBindingMembers(d, meta.(*Config), projectMembersScope, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2ProjectMembersRead(d, meta)
+}
+
+func resourceRancher2ProjectMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Project Members ID %s", d.Id())
+
+	// Removing just the members managed by the resource
+	err := deleteRoleTemplateBindingMembers(d, meta.(*Config), projectMembersScope, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRancher2ProjectMembersImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := meta.(*Config).ProjectExist(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.Set("project_id", d.Id())
+	d.Set("exclude_owner", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// projectMembersScope manages project role template bindings, excluding service accounts ones
+var projectMembersScope = &roleTemplateBindingMembersScope{
+	Name:                "project",
+	OwnerRoleTemplateID: membersProjectOwnerRoleTemplateID,
+	List: func(c *Config, id string) ([]roleTemplateBindingMember, error) {
+		bindings, err := c.GetProjectRoleTemplateBindingsByProjectID(id)
+		if err != nil {
+			return nil, err
+		}
+		members := make([]roleTemplateBindingMember, 0, len(bindings))
+		for _, binding := range bindings {
+			if len(binding.ServiceAccount) > 0 || len(binding.Removed) > 0 {
+				continue
+			}
+			members = append(members, flattenProjectRoleTemplateBindingMember(binding))
+		}
+		return members, nil
+	},
+	Create: func(client *managementClient.Client, id string, member roleTemplateBindingMember) (string, error) {
+		binding, err := client.ProjectRoleTemplateBinding.Create(&managementClient.ProjectRoleTemplateBinding{
+			ProjectID:        id,
+			RoleTemplateID:   member.RoleTemplateID,
+			GroupID:          member.GroupID,
+			GroupPrincipalID: member.GroupPrincipalID,
+			UserID:           member.UserID,
+			UserPrincipalID:  member.UserPrincipalID,
+		})
+		if err != nil {
+			return "", err
+		}
+		return binding.ID, nil
+	},
+	Delete: func(client *managementClient.Client, id string) error {
+		binding, err := client.ProjectRoleTemplateBinding.ByID(id)
+		if err != nil {
+			return err
+		}
+		err = client.ProjectRoleTemplateBinding.Delete(binding)
+		if err != nil {
+			return fmt.Errorf("Deleting project role template binding %s: %v", id, err)
+		}
+		return nil
+	},
+	Refresh: projectRoleTemplateBindingStateRefreshFunc,
+}
@@ -79,8 +79,11 @@ func resourceRancher2TokenRead(d *schema.ResourceData, meta interface{}) error {
 			return resource.NonRetryableError(err)
 		}
 
-		renew := d.Get("renew").(bool)
-		if (!*token.Enabled || token.Expired) && renew {
+		renew, err := isTokenRenewRequired(token, d.Get("rotate_before").(string), time.Now())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if renew && d.Get("renew").(bool) {
 			d.Set("renew", false)
 		}
 
@@ -3,6 +3,7 @@ package rancher2
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -21,6 +22,19 @@ func resourceRancher2User() *schema.Resource {
 		},
 
 		Schema: userFields(),
+		CustomizeDiff: func(d *schema.ResourceDiff, i interface{}) error {
+			if d.Id() == "" && d.NewValueKnown("username") && len(d.Get("username").(string)) == 0 {
+				return fmt.Errorf("[ERROR] username is required")
+			}
+			// External principal users get a random password on create
+			if len(d.Get("principal_id").(string)) > 0 || !d.NewValueKnown("principal_id") {
+				return nil
+			}
+			if d.NewValueKnown("password") && len(d.Get("password").(string)) == 0 {
+				return fmt.Errorf("[ERROR] password is required for local users")
+			}
+			return nil
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -36,6 +50,7 @@ func resourceRancher2UserCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	user := expandUser(d)
+	expandUserPrincipalPassword(user, getUserPasswordMinLength(meta.(*Config)))
 
 	log.Printf("[INFO] Creating User %s", user.Username)
 
@@ -60,6 +75,13 @@ func resourceRancher2UserCreate(d *schema.ResourceData, meta interface{}) error
 			"[ERROR] waiting for user (%s) to be created: %s", newUser.ID, waitErr)
 	}
 
+	if d.Get("refresh_auth_provider_access").(bool) {
+		err = refreshUserAuthProviderAccess(client, newUser.ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceRancher2UserRead(d, meta)
 }
 
@@ -101,10 +123,12 @@ func resourceRancher2UserUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	// Update user password if needed
-	_, user, err = meta.(*Config).SetUserPassword(user, d.Get("password").(string))
-	if err != nil {
-		return fmt.Errorf("[ERROR] Updating Admin password: %s", err)
+	// Update user password if needed. External principal users may not have password
+	if password := d.Get("password").(string); len(password) > 0 {
+		_, user, err = meta.(*Config).SetUserPassword(user, password)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Updating Admin password: %s", err)
+		}
 	}
 
 	update := map[string]interface{}{
@@ -134,6 +158,13 @@ func resourceRancher2UserUpdate(d *schema.ResourceData, meta interface{}) error
 			"[ERROR] waiting for user (%s) to be updated: %s", newUser.ID, waitErr)
 	}
 
+	if d.Get("refresh_auth_provider_access").(bool) {
+		err = refreshUserAuthProviderAccess(client, newUser.ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceRancher2UserRead(d, meta)
 }
 
@@ -181,6 +212,22 @@ func resourceRancher2UserDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// refreshUserAuthProviderAccess refreshes the user group memberships from the auth provider
+func refreshUserAuthProviderAccess(client *managementClient.Client, id string) error {
+	user, err := client.User.ByID(id)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Refreshing User ID %s auth provider access", id)
+	err = client.User.ActionRefreshauthprovideraccess(user)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Refreshing user %s auth provider access: %v", id, err)
+	}
+
+	return nil
+}
+
 // userStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher User.
 func userStateRefreshFunc(client *managementClient.Client, userID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -194,3 +241,23 @@ func userStateRefreshFunc(client *managementClient.Client, userID string) resour
 		return obj, obj.State, nil
 	}
 }
+
+// getUserPasswordMinLength returns the password-min-length setting value, or its default if it can't be read
+func getUserPasswordMinLength(c *Config) int {
+	setting, err := c.GetSettingV2ByID(userPasswordMinLengthSettingID)
+	if err != nil {
+		log.Printf("[WARN] Getting setting %s, using default %d: %v", userPasswordMinLengthSettingID, userPasswordMinLengthDefault, err)
+		return userPasswordMinLengthDefault
+	}
+	value := setting.Value
+	if len(value) == 0 {
+		value = setting.Default
+	}
+	minLength, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("[WARN] Parsing setting %s value %q, using default %d: %v", userPasswordMinLengthSettingID, value, userPasswordMinLengthDefault, err)
+		return userPasswordMinLengthDefault
+	}
+
+	return minLength
+}
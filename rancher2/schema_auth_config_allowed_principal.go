@@ -0,0 +1,48 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const (
+	// authConfigManagedPrincipalIDsAnnotation stores, as json list, the allowed principal IDs managed by rancher2_auth_config_allowed_principal resources
+	authConfigManagedPrincipalIDsAnnotation = "terraform.rancher.io/managed-allowed-principal-ids"
+	authConfigV2APIType                     = rancher2ManagementV2TypePrefix + ".authconfig"
+)
+
+var (
+	authConfigAllowedPrincipalNames = []string{
+		AuthConfigActiveDirectoryName,
+		AuthConfigADFSName,
+		AuthConfigAzureADName,
+		AuthConfigFreeIpaName,
+		AuthConfigGithubName,
+		AuthConfigKeyCloakName,
+		AuthConfigOKTAName,
+		AuthConfigOpenLdapName,
+		AuthConfigPingName,
+	}
+)
+
+//Schemas
+
+func authConfigAllowedPrincipalFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"auth_config_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(authConfigAllowedPrincipalNames, false),
+			Description:  "Auth config name to add the allowed principal to",
+		},
+		"principal_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Principal ID to allow",
+		},
+	}
+
+	return s
+}
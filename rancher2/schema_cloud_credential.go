@@ -1,6 +1,10 @@
 package rancher2
 
 import (
+	"encoding/json"
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 )
@@ -14,19 +18,92 @@ type CloudCredential struct {
 	DigitaloceanCredentialConfig  *digitaloceanCredentialConfig  `json:"digitaloceancredentialConfig,omitempty" yaml:"digitaloceancredentialConfig,omitempty"`
 	GoogleCredentialConfig        *googleCredentialConfig        `json:"googlecredentialConfig,omitempty" yaml:"googlecredentialConfig,omitempty"`
 	HarvesterCredentialConfig     *harvesterCredentialConfig     `json:"harvestercredentialConfig,omitempty" yaml:"harvestercredentialConfig,omitempty"`
+	HetznerCredentialConfig       *hetznerCredentialConfig       `json:"hetznercredentialConfig,omitempty" yaml:"hetznercredentialConfig,omitempty"`
 	LinodeCredentialConfig        *linodeCredentialConfig        `json:"linodecredentialConfig,omitempty" yaml:"linodecredentialConfig,omitempty"`
+	OpennebulaCredentialConfig    *opennebulaCredentialConfig    `json:"opennebulacredentialConfig,omitempty" yaml:"opennebulacredentialConfig,omitempty"`
 	OpenstackCredentialConfig     *openstackCredentialConfig     `json:"openstackcredentialConfig,omitempty" yaml:"openstackcredentialConfig,omitempty"`
+	OutscaleCredentialConfig      *outscaleCredentialConfig      `json:"outscalecredentialConfig,omitempty" yaml:"outscalecredentialConfig,omitempty"`
 	VmwarevsphereCredentialConfig *vmwarevsphereCredentialConfig `json:"vmwarevspherecredentialConfig,omitempty" yaml:"vmwarevspherecredentialConfig,omitempty"`
+	Driver                        string                         `json:"-" yaml:"-"`
+	DriverConfig                  map[string]interface{}         `json:"-" yaml:"-"`
+}
+
+// MarshalJSON adds DriverConfig as <driver>credentialConfig field, for drivers without dedicated config
+func (c CloudCredential) MarshalJSON() ([]byte, error) {
+	// Avoiding MarshalJSON recursion
+	type cloudCredential CloudCredential
+	out, err := json.Marshal(cloudCredential(c))
+	if err != nil || c.DriverConfig == nil || len(c.Driver) == 0 {
+		return out, err
+	}
+	obj := map[string]interface{}{}
+	err = json.Unmarshal(out, &obj)
+	if err != nil {
+		return nil, err
+	}
+	obj[c.Driver+cloudCredentialDriverConfigSuffix] = c.DriverConfig
+
+	return json.Marshal(obj)
+}
+
+// UnmarshalJSON sets Driver and DriverConfig from <driver>credentialConfig field, for drivers without dedicated config
+func (c *CloudCredential) UnmarshalJSON(data []byte) error {
+	// Avoiding UnmarshalJSON recursion
+	type cloudCredential CloudCredential
+	obj := cloudCredential{}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	*c = CloudCredential(obj)
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	for k, v := range fields {
+		driver, ok := strings.CutSuffix(k, cloudCredentialDriverConfigSuffix)
+		if !ok || len(driver) == 0 || slices.Contains(cloudCredentialBuiltinDrivers, driver) || string(v) == "null" {
+			continue
+		}
+		c.Driver = driver
+		return json.Unmarshal(v, &c.DriverConfig)
+	}
+
+	return nil
+}
+
+const cloudCredentialDriverConfigSuffix = "credentialConfig"
+
+// cloudCredentialBuiltinDrivers are the drivers having dedicated credential config argument
+var cloudCredentialBuiltinDrivers = []string{
+	amazonec2ConfigDriver,
+	azureConfigDriver,
+	digitaloceanConfigDriver,
+	"google",
+	googleConfigDriver,
+	harvesterConfigDriver,
+	hetznerConfigDriver,
+	linodeConfigDriver,
+	opennebulaConfigDriver,
+	openstackConfigDriver,
+	outscaleConfigDriver,
+	s3ConfigDriver,
+	vmwarevsphereConfigDriver,
 }
 
 var allCloudCredentialDriverConfigFields = []string{
 	"amazonec2_credential_config",
 	"azure_credential_config",
 	"digitalocean_credential_config",
+	"driver_config",
 	"google_credential_config",
 	"harvester_credential_config",
+	"hetzner_credential_config",
 	"linode_credential_config",
+	"opennebula_credential_config",
 	"openstack_credential_config",
+	"outscale_credential_config",
 	"s3_credential_config",
 	"vsphere_credential_config"}
 
@@ -73,6 +150,16 @@ func cloudCredentialFields() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"driver_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allCloudCredentialDriverConfigFields, "driver_config"),
+			Description:   "Cloud credential config for node drivers without dedicated argument. Fields are validated against the driver credential config schema",
+			Elem: &schema.Resource{
+				Schema: driverConfigFields(validateDriverConfigDriver(cloudCredentialBuiltinDrivers)),
+			},
+		},
 		"google_credential_config": {
 			Type:          schema.TypeList,
 			MaxItems:      1,
@@ -91,6 +178,15 @@ func cloudCredentialFields() map[string]*schema.Schema {
 				Schema: cloudCredentialHarvesterFields(),
 			},
 		},
+		"hetzner_credential_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allCloudCredentialDriverConfigFields, "hetzner_credential_config"),
+			Elem: &schema.Resource{
+				Schema: cloudCredentialHetznerFields(),
+			},
+		},
 		"linode_credential_config": {
 			Type:          schema.TypeList,
 			MaxItems:      1,
@@ -100,6 +196,15 @@ func cloudCredentialFields() map[string]*schema.Schema {
 				Schema: cloudCredentialLinodeFields(),
 			},
 		},
+		"opennebula_credential_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allCloudCredentialDriverConfigFields, "opennebula_credential_config"),
+			Elem: &schema.Resource{
+				Schema: cloudCredentialOpennebulaFields(),
+			},
+		},
 		"openstack_credential_config": {
 			Type:          schema.TypeList,
 			MaxItems:      1,
@@ -109,6 +214,21 @@ func cloudCredentialFields() map[string]*schema.Schema {
 				Schema: cloudCredentialOpenstackFields(),
 			},
 		},
+		"outscale_credential_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allCloudCredentialDriverConfigFields, "outscale_credential_config"),
+			Elem: &schema.Resource{
+				Schema: cloudCredentialOutscaleFields(),
+			},
+		},
+		"prevent_destroy_if_in_use": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Prevent cloud credential deletion while it is used by cluster V2 machine pools, etcd S3 configs or node templates",
+		},
 		"s3_credential_config": {
 			Type:          schema.TypeList,
 			MaxItems:      1,
@@ -133,5 +253,9 @@ func cloudCredentialFields() map[string]*schema.Schema {
 		s[k] = v
 	}
 
+	for k, v := range cloudCredentialUsageFields() {
+		s[k] = v
+	}
+
 	return s
 }
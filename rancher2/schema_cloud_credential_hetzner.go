@@ -0,0 +1,26 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Types
+
+type hetznerCredentialConfig struct {
+	APIToken string `json:"apiToken,omitempty" yaml:"apiToken,omitempty"`
+}
+
+// Schemas
+
+func cloudCredentialHetznerFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"api_token": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Hetzner Cloud project API token",
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,38 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Types
+
+type opennebulaCredentialConfig struct {
+	Password  string `json:"password,omitempty" yaml:"password,omitempty"`
+	User      string `json:"user,omitempty" yaml:"user,omitempty"`
+	XMLRPCURL string `json:"xmlrpcurl,omitempty" yaml:"xmlrpcurl,omitempty"`
+}
+
+// Schemas
+
+func cloudCredentialOpennebulaFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "OpenNebula password for the XML-RPC API authentication",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "OpenNebula user for the XML-RPC API authentication",
+		},
+		"xml_rpc_url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "OpenNebula XML-RPC API url",
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,72 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const cloudCredentialIDSep = ":"
+
+//Types
+
+type cloudCredentialUsageMachinePool struct {
+	ClusterID string
+	Name      string
+}
+
+// cloudCredentialUsage holds the objects referencing a cloud credential
+type cloudCredentialUsage struct {
+	MachinePools     []cloudCredentialUsageMachinePool
+	NodeTemplateIDs  []string
+	EtcdS3ClusterIDs []string
+}
+
+//Schemas
+
+func cloudCredentialUsageMachinePoolFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cluster V2 ID",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Machine pool name",
+		},
+	}
+
+	return s
+}
+
+// cloudCredentialUsageFields returns the computed fields listing the objects referencing the cloud credential
+func cloudCredentialUsageFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"used_by_etcd_s3_cluster_ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Cluster V2 IDs using the cloud credential at etcd snapshot S3 config",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"used_by_machine_pools": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Cluster V2 machine pools using the cloud credential",
+			Elem: &schema.Resource{
+				Schema: cloudCredentialUsageMachinePoolFields(),
+			},
+		},
+		"used_by_node_template_ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Node template IDs using the cloud credential",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	return s
+}
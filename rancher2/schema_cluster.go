@@ -1,6 +1,11 @@
 package rancher2
 
 import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
@@ -8,6 +13,7 @@ import (
 
 const (
 	clusterDriverImported        = "imported"
+	clusterStateActive           = "active"
 	clusterRegistrationTokenName = "default-token"
 	clusterActiveCondition       = "Updated"
 	clusterConnectedCondition    = "Connected"
@@ -26,6 +32,83 @@ type Cluster struct {
 	AzureKubernetesServiceConfig        *AzureKubernetesServiceConfig        `json:"azureKubernetesServiceConfig,omitempty" yaml:"azureKubernetesServiceConfig,omitempty"`
 	GoogleKubernetesEngineConfig        *GoogleKubernetesEngineConfig        `json:"googleKubernetesEngineConfig,omitempty" yaml:"googleKubernetesEngineConfig,omitempty"`
 	OracleKubernetesEngineConfig        *OracleKubernetesEngineConfig        `json:"okeEngineConfig,omitempty" yaml:"okeEngineConfig,omitempty"`
+	GenericConfig                       map[string]interface{}               `json:"-" yaml:"-"`
+	GenericConfigDriver                 string                               `json:"-" yaml:"-"`
+}
+
+// MarshalJSON adds GenericConfig as <driver>EngineConfig field, for kontainer drivers without dedicated config
+func (c Cluster) MarshalJSON() ([]byte, error) {
+	// Avoiding MarshalJSON recursion
+	type cluster Cluster
+	out, err := json.Marshal(cluster(c))
+	if err != nil || c.GenericConfig == nil || len(c.GenericConfigDriver) == 0 {
+		return out, err
+	}
+	obj := map[string]interface{}{}
+	err = json.Unmarshal(out, &obj)
+	if err != nil {
+		return nil, err
+	}
+	obj[c.GenericConfigDriver+clusterDriverConfigSuffix] = c.GenericConfig
+
+	return json.Marshal(obj)
+}
+
+// UnmarshalJSON sets GenericConfig from <driver>EngineConfig field, for kontainer drivers without dedicated config.
+// The field is picked from the cluster driver, or from the config driverName if the kontainer driver display name
+// differs from its name. driverName field is set by Rancher, so it's removed
+func (c *Cluster) UnmarshalJSON(data []byte) error {
+	// Avoiding UnmarshalJSON recursion
+	type cluster Cluster
+	obj := cluster{}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	*c = Cluster(obj)
+	if len(c.Driver) == 0 || slices.Contains(clusterGenericConfigBuiltinDrivers, c.Driver) {
+		return nil
+	}
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	configs := map[string]map[string]interface{}{}
+	for k, v := range fields {
+		if !strings.HasSuffix(k, clusterDriverConfigSuffix) || slices.Contains(clusterGenericConfigReservedFields, k) {
+			continue
+		}
+		config := map[string]interface{}{}
+		if err = json.Unmarshal(v, &config); err != nil || len(config) == 0 {
+			// Not a generic config object, like null values
+			continue
+		}
+		configs[k] = config
+	}
+	key := c.Driver + clusterDriverConfigSuffix
+	if _, ok := configs[key]; !ok {
+		key = ""
+		keys := make([]string, 0, len(configs))
+		for k := range configs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if driverName, _ := configs[k][clusterGenericConfigDriverName].(string); driverName == c.Driver {
+				key = k
+				break
+			}
+		}
+	}
+	if len(key) == 0 {
+		return nil
+	}
+	delete(configs[key], clusterGenericConfigDriverName)
+	c.GenericConfig = configs[key]
+	c.GenericConfigDriver = strings.TrimSuffix(key, clusterDriverConfigSuffix)
+
+	return nil
 }
 
 // Schemas
@@ -332,7 +415,7 @@ func clusterFields() map[string]*schema.Schema {
 			MaxItems:      1,
 			Optional:      true,
 			Computed:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "generic_config", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterRKEConfigFields(),
 			},
@@ -342,7 +425,7 @@ func clusterFields() map[string]*schema.Schema {
 			MaxItems:      1,
 			Optional:      true,
 			Computed:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "generic_config", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config"},
 			Elem: &schema.Resource{
 				Schema: clusterRKE2ConfigFields(),
 			},
@@ -352,7 +435,7 @@ func clusterFields() map[string]*schema.Schema {
 			MaxItems:      1,
 			Optional:      true,
 			Computed:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "gke_config", "gke_config_v2", "oke_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "generic_config", "gke_config", "gke_config_v2", "oke_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterK3SConfigFields(),
 			},
@@ -361,7 +444,7 @@ func clusterFields() map[string]*schema.Schema {
 			Type:          schema.TypeList,
 			MaxItems:      1,
 			Optional:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config_v2", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config_v2", "generic_config", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterEKSConfigFields(),
 			},
@@ -371,7 +454,7 @@ func clusterFields() map[string]*schema.Schema {
 			MaxItems:      1,
 			Optional:      true,
 			Computed:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "generic_config", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterEKSConfigV2Fields(),
 			},
@@ -380,7 +463,7 @@ func clusterFields() map[string]*schema.Schema {
 			Type:          schema.TypeList,
 			MaxItems:      1,
 			Optional:      true,
-			ConflictsWith: []string{"eks_config", "aks_config_v2", "eks_config_v2", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"eks_config", "aks_config_v2", "eks_config_v2", "generic_config", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterAKSConfigFields(),
 			},
@@ -389,7 +472,7 @@ func clusterFields() map[string]*schema.Schema {
 			Type:          schema.TypeList,
 			MaxItems:      1,
 			Optional:      true,
-			ConflictsWith: []string{"aks_config", "eks_config", "eks_config_v2", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "eks_config", "eks_config_v2", "generic_config", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterAKSConfigV2Fields(),
 			},
@@ -398,7 +481,7 @@ func clusterFields() map[string]*schema.Schema {
 			Type:          schema.TypeList,
 			MaxItems:      1,
 			Optional:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "generic_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterGKEConfigFields(),
 			},
@@ -407,7 +490,7 @@ func clusterFields() map[string]*schema.Schema {
 			Type:          schema.TypeList,
 			MaxItems:      1,
 			Optional:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "gke_config", "k3s_config", "oke_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "generic_config", "gke_config", "k3s_config", "oke_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterGKEConfigV2Fields(),
 			},
@@ -416,11 +499,48 @@ func clusterFields() map[string]*schema.Schema {
 			Type:          schema.TypeList,
 			MaxItems:      1,
 			Optional:      true,
-			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "gke_config", "gke_config_v2", "k3s_config", "rke_config", "rke2_config"},
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "generic_config", "gke_config", "gke_config_v2", "k3s_config", "rke_config", "rke2_config"},
 			Elem: &schema.Resource{
 				Schema: clusterOKEConfigFields(),
 			},
 		},
+		"generic_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"aks_config", "aks_config_v2", "eks_config", "eks_config_v2", "gke_config", "gke_config_v2", "k3s_config", "oke_config", "rke_config", "rke2_config"},
+			Description:   "Generic config for kontainer drivers without dedicated argument, sent as <driver>EngineConfig",
+			Elem: &schema.Resource{
+				Schema: clusterGenericConfigFields(),
+			},
+		},
+		"aks_config_v2_upstream": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Computed:    true,
+			Description: "The AKS cluster upstream spec, as seen by Rancher",
+			Elem: &schema.Resource{
+				Schema: clusterAKSConfigV2Fields(),
+			},
+		},
+		"eks_config_v2_upstream": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Computed:    true,
+			Description: "The EKS cluster upstream spec, as seen by Rancher",
+			Elem: &schema.Resource{
+				Schema: clusterEKSConfigV2Fields(),
+			},
+		},
+		"gke_config_v2_upstream": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Computed:    true,
+			Description: "The GKE cluster upstream spec, as seen by Rancher",
+			Elem: &schema.Resource{
+				Schema: clusterGKEConfigV2Fields(),
+			},
+		},
 		"default_project_id": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -535,5 +655,9 @@ func clusterFields() map[string]*schema.Schema {
 		s[k] = v
 	}
 
+	for k, v := range clusterKubeConfigFields() {
+		s[k] = v
+	}
+
 	return s
 }
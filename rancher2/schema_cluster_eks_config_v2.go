@@ -229,6 +229,12 @@ func clusterEKSConfigV2Fields() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"read_only_node_groups": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Track imported EKS cluster node groups read-only from upstream, without managing them. Just for imported clusters",
+		},
 		"region": {
 			Type:        schema.TypeString,
 			Optional:    true,
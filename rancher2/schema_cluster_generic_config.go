@@ -0,0 +1,35 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	clusterDriverBuiltinConfigSuffix = "Config"
+	clusterDriverConfigSuffix        = "EngineConfig"
+	clusterGenericConfigDriverName   = "driverName"
+)
+
+var (
+	// clusterGenericConfigBuiltinDrivers are the kontainer drivers with dedicated cluster config arguments
+	clusterGenericConfigBuiltinDrivers = []string{
+		clusterDriverAKS,
+		clusterDriverEKS,
+		clusterDriverGKE,
+		clusterDriverOKE,
+		clusterOKEKind,
+	}
+	// clusterGenericConfigReservedFields are the cluster fields ending with EngineConfig not being a generic config
+	clusterGenericConfigReservedFields = []string{
+		"genericEngineConfig",
+		"googleKubernetesEngineConfig",
+		"okeEngineConfig",
+		"rancherKubernetesEngineConfig",
+	}
+)
+
+//Schemas
+
+func clusterGenericConfigFields() map[string]*schema.Schema {
+	return driverConfigFields(validateDriverConfigDriver(clusterGenericConfigBuiltinDrivers))
+}
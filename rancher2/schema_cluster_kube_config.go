@@ -0,0 +1,90 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	clusterKubeConfigEndpointRancherProxy = "rancher_proxy"
+	clusterKubeConfigEndpointACEFQDN      = "ace_fqdn"
+	clusterKubeConfigEndpointACENode      = "ace_node"
+	clusterKubeConfigFQDNContextSuffix    = "-fqdn"
+	clusterKubeConfigProxyPath            = "/k8s/clusters/"
+)
+
+//Schemas
+
+func clusterKubeConfigContextFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kube config context name",
+		},
+		"server": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kube config context server URL",
+		},
+		"certificate_authority": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kube config context CA certificate, PEM format",
+		},
+		"token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Kube config context user token",
+		},
+		"endpoint_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kube config context endpoint type (rancher_proxy, ace_fqdn or ace_node)",
+		},
+		"current": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Kube config context is the current context",
+		},
+	}
+
+	return s
+}
+
+func clusterKubeConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"kube_config_context": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Kube config context to set as current context. Rancher default if empty",
+		},
+		"kube_config_contexts": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Kube config contexts",
+			Elem: &schema.Resource{
+				Schema: clusterKubeConfigContextFields(),
+			},
+		},
+		"kube_config_host": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kube config current context server URL",
+		},
+		"kube_config_cluster_ca_certificate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kube config current context CA certificate, PEM format",
+		},
+		"kube_config_token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Kube config current context user token",
+		},
+	}
+
+	return s
+}
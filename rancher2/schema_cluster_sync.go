@@ -63,6 +63,10 @@ func clusterSyncFields() map[string]*schema.Schema {
 		},
 	}
 
+	for k, v := range clusterKubeConfigFields() {
+		s[k] = v
+	}
+
 	return s
 }
 
@@ -231,5 +231,9 @@ func clusterV2Fields() map[string]*schema.Schema {
 		s[k] = v
 	}
 
+	for k, v := range clusterKubeConfigFields() {
+		s[k] = v
+	}
+
 	return s
 }
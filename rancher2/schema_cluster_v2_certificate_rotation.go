@@ -0,0 +1,86 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	clusterV2CertificatesExpiresWithinDefault = "720h"
+	clusterV2CertificatesNamespace            = "kube-system"
+)
+
+var (
+	// clusterV2ServingCertificates are the rke2 and k3s supervisor and apiserver serving certificate secrets
+	clusterV2ServingCertificates = []string{
+		"rke2-serving",
+		"k3s-serving",
+	}
+)
+
+//Types
+
+// clusterV2CertificateExpiration is a certificate expiration date
+type clusterV2CertificateExpiration struct {
+	Name           string
+	ExpirationDate string
+}
+
+//Schemas
+
+func clusterV2CertificateExpirationFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Certificate name",
+		},
+		"expiration_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Certificate expiration date",
+		},
+	}
+
+	return s
+}
+
+func clusterV2CertificateRotationFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Cluster V2 ID (<fleet_namespace>/<name>)",
+		},
+		"services": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Service certificates to rotate. All certificates are rotated if empty",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary map of values that, when changed, will trigger a new certificates rotation",
+		},
+		"generation": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Certificate rotation generation used to trigger the rotation",
+		},
+		"certificates": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Cluster certificates expiration dates",
+			Elem: &schema.Resource{
+				Schema: clusterV2CertificateExpirationFields(),
+			},
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,92 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	norman "github.com/rancher/norman/types"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	clusterV2ETCDRestoreModeETCDOnly          = "etcd_only"
+	clusterV2ETCDRestoreModeKubernetesVersion = "kubernetes_version"
+	clusterV2ETCDRestoreModeAll               = "all"
+	clusterV2RKEControlPlaneAPIType           = "rke.cattle.io.rkecontrolplane"
+	clusterV2MachineAPIType                   = "cluster.x-k8s.io.machine"
+	clusterV2MachineClusterNameLabel          = "cluster.x-k8s.io/cluster-name"
+	clusterV2ETCDRestoreIDSeparator           = ":"
+)
+
+var (
+	clusterV2ETCDRestoreModes = map[string]string{
+		clusterV2ETCDRestoreModeETCDOnly:          "none",
+		clusterV2ETCDRestoreModeKubernetesVersion: "kubernetesVersion",
+		clusterV2ETCDRestoreModeAll:               "all",
+	}
+)
+
+//Types
+
+type ClusterV2RKEControlPlane struct {
+	norman.Resource
+	rkev1.RKEControlPlane
+}
+
+type ClusterV2Machine struct {
+	norman.Resource
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+}
+
+//Schemas
+
+func clusterV2ETCDRestoreFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Cluster V2 ID (<fleet_namespace>/<name>)",
+		},
+		"snapshot_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "ETCD snapshot name to restore",
+		},
+		"restore_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      clusterV2ETCDRestoreModeETCDOnly,
+			ValidateFunc: validation.StringInSlice([]string{clusterV2ETCDRestoreModeETCDOnly, clusterV2ETCDRestoreModeKubernetesVersion, clusterV2ETCDRestoreModeAll}, false),
+			Description:  "ETCD restore mode (set to etcd_only, kubernetes_version or all)",
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary map of values that, when changed, will trigger a new etcd snapshot restore",
+		},
+		"generation": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "ETCD snapshot restore generation used to trigger the restore",
+		},
+		"phase": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ETCD snapshot restore phase",
+		},
+		"machines_rolled": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Machines replaced during the restore",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	return s
+}
@@ -248,6 +248,16 @@ func clusterV2RKEConfigFields() map[string]*schema.Schema {
 				return reflect.DeepEqual(oldMap, newMap)
 			},
 		},
+		"machine_global_settings": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"rke_config.0.machine_global_config"},
+			Description:   "Cluster V2 machine global config as typed settings",
+			Elem: &schema.Resource{
+				Schema: clusterV2RKEConfigMachineSettingsFields(),
+			},
+		},
 		"machine_pools": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -0,0 +1,400 @@
+package rancher2
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const (
+	clusterV2RKEConfigSystemConfigDefault = "protect-kernel-defaults: false"
+)
+
+var (
+	clusterV2RKEConfigMachineSettingsKeyRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+	// Typed machine settings fields, mapped to the RKE2/K3s config key they set
+	clusterV2RKEConfigMachineSettingsStringKeys = map[string]string{
+		"cni":     "cni",
+		"profile": "profile",
+	}
+	clusterV2RKEConfigMachineSettingsListKeys = map[string]string{
+		"disable":                           "disable",
+		"tls_san":                           "tls-san",
+		"kube_apiserver_arg":                "kube-apiserver-arg",
+		"kube_controller_manager_arg":       "kube-controller-manager-arg",
+		"kube_scheduler_arg":                "kube-scheduler-arg",
+		"kube_proxy_arg":                    "kube-proxy-arg",
+		"kube_cloud_controller_manager_arg": "kube-cloud-controller-manager-arg",
+		"kubelet_arg":                       "kubelet-arg",
+	}
+	// Bool settings are strings, to send explicit false values and to leave them unset if empty
+	clusterV2RKEConfigMachineSettingsBoolKeys = map[string]string{
+		"protect_kernel_defaults": "protect-kernel-defaults",
+		"selinux":                 "selinux",
+	}
+
+	// RKE2 and K3s server/agent config keys accepted at extra_config
+	clusterV2RKEConfigMachineSettingsKnownKeys = []string{
+		"advertise-address",
+		"advertise-port",
+		"agent-token",
+		"agent-token-file",
+		"airgap-extra-registry",
+		"audit-policy-file",
+		"bind-address",
+		"cloud-controller-manager-extra-env",
+		"cloud-controller-manager-extra-mount",
+		"cloud-controller-manager-image",
+		"cloud-provider-config",
+		"cloud-provider-name",
+		"cluster-cidr",
+		"cluster-dns",
+		"cluster-domain",
+		"cluster-init",
+		"cni",
+		"container-runtime-endpoint",
+		"control-plane-probe-configuration",
+		"control-plane-resource-limits",
+		"control-plane-resource-requests",
+		"data-dir",
+		"datastore-cafile",
+		"datastore-certfile",
+		"datastore-endpoint",
+		"datastore-keyfile",
+		"debug",
+		"default-local-storage-path",
+		"default-runtime",
+		"disable",
+		"disable-apiserver",
+		"disable-cloud-controller",
+		"disable-controller-manager",
+		"disable-default-registry-endpoint",
+		"disable-etcd",
+		"disable-helm-controller",
+		"disable-kube-proxy",
+		"disable-network-policy",
+		"disable-scheduler",
+		"egress-selector-mode",
+		"embedded-registry",
+		"enable-pprof",
+		"enable-servicelb",
+		"etcd-arg",
+		"etcd-disable-snapshots",
+		"etcd-expose-metrics",
+		"etcd-extra-env",
+		"etcd-extra-mount",
+		"etcd-image",
+		"etcd-s3",
+		"etcd-s3-access-key",
+		"etcd-s3-bucket",
+		"etcd-s3-config-secret",
+		"etcd-s3-endpoint",
+		"etcd-s3-endpoint-ca",
+		"etcd-s3-folder",
+		"etcd-s3-insecure",
+		"etcd-s3-proxy",
+		"etcd-s3-region",
+		"etcd-s3-secret-key",
+		"etcd-s3-skip-ssl-verify",
+		"etcd-s3-timeout",
+		"etcd-snapshot-compress",
+		"etcd-snapshot-dir",
+		"etcd-snapshot-name",
+		"etcd-snapshot-retention",
+		"etcd-snapshot-schedule-cron",
+		"flannel-backend",
+		"flannel-cni-conf",
+		"flannel-conf",
+		"flannel-external-ip",
+		"flannel-iface",
+		"flannel-ipv6-masq",
+		"helm-job-image",
+		"image-credential-provider-bin-dir",
+		"image-credential-provider-config",
+		"ingress-controller",
+		"kube-apiserver-arg",
+		"kube-apiserver-extra-env",
+		"kube-apiserver-extra-mount",
+		"kube-apiserver-image",
+		"kube-cloud-controller-manager-arg",
+		"kube-controller-manager-arg",
+		"kube-controller-manager-extra-env",
+		"kube-controller-manager-extra-mount",
+		"kube-controller-manager-image",
+		"kube-proxy-arg",
+		"kube-proxy-extra-env",
+		"kube-proxy-extra-mount",
+		"kube-proxy-image",
+		"kube-scheduler-arg",
+		"kube-scheduler-extra-env",
+		"kube-scheduler-extra-mount",
+		"kube-scheduler-image",
+		"kubelet-arg",
+		"kubelet-path",
+		"lb-server-port",
+		"node-external-dns",
+		"node-external-ip",
+		"node-internal-dns",
+		"node-ip",
+		"node-label",
+		"node-name",
+		"node-taint",
+		"pause-image",
+		"pod-security-admission-config-file",
+		"prefer-bundled-bin",
+		"private-registry",
+		"profile",
+		"protect-kernel-defaults",
+		"resolv-conf",
+		"runtime-image",
+		"secrets-encryption",
+		"secrets-encryption-provider",
+		"selinux",
+		"service-cidr",
+		"service-node-port-range",
+		"servicelb-namespace",
+		"snapshotter",
+		"supervisor-metrics",
+		"system-default-registry",
+		"tls-san",
+		"tls-san-security",
+		"vpn-auth",
+		"vpn-auth-file",
+		"with-node-id",
+		"write-kubeconfig",
+		"write-kubeconfig-group",
+		"write-kubeconfig-mode",
+	}
+)
+
+//Types
+
+func clusterV2RKEConfigMachineSettingsFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cni": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "CNI plugin to deploy, `cni` config key",
+		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "CIS profile to validate the node configuration against, `profile` config key",
+		},
+		"protect_kernel_defaults": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			Description:  "Exit the kubelet if kernel tunables differ from its defaults, `protect-kernel-defaults` config key. Not set if empty",
+		},
+		"selinux": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			Description:  "Enable SELinux in the container runtime, `selinux` config key. Not set if empty",
+		},
+		"disable": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Packaged components to disable, `disable` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tls_san": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Additional hostnames or IPs as Subject Alternative Names on the server TLS cert, `tls-san` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"kube_apiserver_arg": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Customized flags for the kube-apiserver process, `kube-apiserver-arg` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"kube_controller_manager_arg": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Customized flags for the kube-controller-manager process, `kube-controller-manager-arg` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"kube_scheduler_arg": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Customized flags for the kube-scheduler process, `kube-scheduler-arg` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"kube_proxy_arg": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Customized flags for the kube-proxy process, `kube-proxy-arg` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"kube_cloud_controller_manager_arg": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Customized flags for the cloud-controller-manager process, `kube-cloud-controller-manager-arg` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"kubelet_arg": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Customized flags for the kubelet process, `kubelet-arg` config key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"extra_config": {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Description:  "Additional RKE2/K3s config keys. Values are written as strings",
+			ValidateFunc: validateClusterV2RKEConfigMachineSettingsExtraConfig,
+		},
+		"extra_config_yaml": {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Description:  "Additional RKE2/K3s config keys. Values are decoded as YAML, for lists, maps, booleans or numbers",
+			ValidateFunc: validateClusterV2RKEConfigMachineSettingsExtraConfigYAML,
+		},
+	}
+
+	return s
+}
+
+// Validators
+
+func clusterV2RKEConfigMachineSettingsTypedField(key string) string {
+	for _, keys := range []map[string]string{
+		clusterV2RKEConfigMachineSettingsStringKeys,
+		clusterV2RKEConfigMachineSettingsListKeys,
+		clusterV2RKEConfigMachineSettingsBoolKeys,
+	} {
+		for field, configKey := range keys {
+			if configKey == key {
+				return field
+			}
+		}
+	}
+	return ""
+}
+
+func validateClusterV2RKEConfigMachineSettingsExtraConfig(val interface{}, key string) (warns []string, errs []error) {
+	return validateClusterV2RKEConfigMachineSettingsExtraConfigKeys(val, key, false)
+}
+
+func validateClusterV2RKEConfigMachineSettingsExtraConfigYAML(val interface{}, key string) (warns []string, errs []error) {
+	return validateClusterV2RKEConfigMachineSettingsExtraConfigKeys(val, key, true)
+}
+
+func validateClusterV2RKEConfigMachineSettingsExtraConfigKeys(val interface{}, key string, yaml bool) (warns []string, errs []error) {
+	v, ok := val.(map[string]interface{})
+	if !ok || len(v) == 0 {
+		return
+	}
+	known := make(map[string]bool, len(clusterV2RKEConfigMachineSettingsKnownKeys))
+	for _, k := range clusterV2RKEConfigMachineSettingsKnownKeys {
+		known[k] = true
+	}
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if field := clusterV2RKEConfigMachineSettingsTypedField(k); len(field) > 0 {
+			errs = append(errs, fmt.Errorf("%q: config key %q conflicts with the typed %q field, set it there instead", key, k, field))
+			continue
+		}
+		if !clusterV2RKEConfigMachineSettingsKeyRegexp.MatchString(k) || !known[k] {
+			errs = append(errs, fmt.Errorf("%q: unknown RKE2/K3s config key %q", key, k))
+			continue
+		}
+		if s, ok := v[k].(string); ok && yaml {
+			var out interface{}
+			if err := ghodssyamlToInterface(s, &out); err != nil {
+				errs = append(errs, fmt.Errorf("%q: value of config key %q must be in yaml format, error: %v", key, k, err))
+			}
+		}
+	}
+	return
+}
+
+// validateClusterV2RKEConfigMachineSettingsExtraConfigDuplicates checks that config keys aren't set at both extra_config
+// and extra_config_yaml
+func validateClusterV2RKEConfigMachineSettingsExtraConfigDuplicates(p []interface{}, path string) error {
+	if len(p) == 0 || p[0] == nil {
+		return nil
+	}
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	extra, _ := in["extra_config"].(map[string]interface{})
+	extraYAML, _ := in["extra_config_yaml"].(map[string]interface{})
+	keys := []string{}
+	for k := range extraYAML {
+		if _, ok := extra[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		return fmt.Errorf("%s: config keys %q are set at both \"extra_config\" and \"extra_config_yaml\", only one of them can be set", path, keys)
+	}
+	return nil
+}
+
+// validateClusterV2RKEConfigMachineSettings checks that raw YAML config and typed settings
+// aren't both set on the same machine selector config, and extra config keys aren't duplicated
+func validateClusterV2RKEConfigMachineSettings(p []interface{}) error {
+	if len(p) == 0 || p[0] == nil {
+		return nil
+	}
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if settings, ok := in["machine_global_settings"].([]interface{}); ok {
+		if err := validateClusterV2RKEConfigMachineSettingsExtraConfigDuplicates(settings, "rke_config.0.machine_global_settings.0"); err != nil {
+			return err
+		}
+	}
+	selectors, ok := in["machine_selector_config"].([]interface{})
+	if !ok {
+		return nil
+	}
+	for i := range selectors {
+		selector, ok := selectors[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		settings, ok := selector["settings"].([]interface{})
+		if !ok || len(settings) == 0 {
+			continue
+		}
+		if config, ok := selector["config"].(string); ok && len(config) > 0 && config != clusterV2RKEConfigSystemConfigDefault {
+			return fmt.Errorf("rke_config.0.machine_selector_config.%d: \"config\" conflicts with \"settings\", only one of them can be set", i)
+		}
+		if err := validateClusterV2RKEConfigMachineSettingsExtraConfigDuplicates(settings, fmt.Sprintf("rke_config.0.machine_selector_config.%d.settings.0", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -90,7 +90,7 @@ func clusterV2RKEConfigSystemConfigFields() map[string]*schema.Schema {
 		"config": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Default:     "protect-kernel-defaults: false",
+			Default:     clusterV2RKEConfigSystemConfigDefault,
 			Description: "Machine selector config",
 			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
 				v, ok := val.(string)
@@ -113,6 +113,15 @@ func clusterV2RKEConfigSystemConfigFields() map[string]*schema.Schema {
 				return reflect.DeepEqual(oldMap, newMap)
 			},
 		},
+		"settings": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "Machine selector config as typed settings",
+			Elem: &schema.Resource{
+				Schema: clusterV2RKEConfigMachineSettingsFields(),
+			},
+		},
 	}
 
 	return s
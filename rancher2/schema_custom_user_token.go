@@ -58,7 +58,7 @@ func customUserTokenFields() map[string]*schema.Schema {
 			Optional:    true,
 			ForceNew:    true,
 			Default:     true,
-			Description: "Renew expired or disabled token",
+			Description: "Renew expired, disabled or expiring token",
 		},
 		"secret_key": {
 			Type:        schema.TypeString,
@@ -97,6 +97,9 @@ func customUserTokenFields() map[string]*schema.Schema {
 		},
 	}
 
+	for k, v := range tokenRotationFields() {
+		s[k] = v
+	}
 	for k, v := range commonAnnotationLabelFields() {
 		s[k] = v
 	}
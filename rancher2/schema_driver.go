@@ -0,0 +1,38 @@
+package rancher2
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	driverTypeCluster = "cluster"
+	driverTypeNode    = "node"
+)
+
+var driverTypes = []string{driverTypeCluster, driverTypeNode}
+
+// validateDriverURL checks that the driver binary URL uses https and that its host is covered by whitelistDomains.
+// Whitelist domains match the host and its subdomains, `*.` prefix is optional
+func validateDriverURL(in string, whitelistDomains []string) error {
+	u, err := url.Parse(in)
+	if err != nil {
+		return fmt.Errorf("[ERROR] driver url %q is not valid: %v", in, err)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("[ERROR] driver url %q must use https", in)
+	}
+	host := strings.ToLower(u.Hostname())
+	if len(host) == 0 {
+		return fmt.Errorf("[ERROR] driver url %q has no host", in)
+	}
+	for _, domain := range whitelistDomains {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*.")
+		if len(domain) > 0 && (host == domain || strings.HasSuffix(host, "."+domain)) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("[ERROR] driver url host %s must be covered by whitelist_domains", host)
+}
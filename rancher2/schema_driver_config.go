@@ -0,0 +1,62 @@
+package rancher2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const driverConfigPasswordType = "password"
+
+//Schemas
+
+// driverConfigFields returns the schema of a generic driver config, for drivers without dedicated arguments
+func driverConfigFields(validateDriver schema.SchemaValidateFunc) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"driver": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateDriver,
+			Description:  "Driver name, it should be active",
+		},
+		"fields": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Driver config fields. Arrays are set as comma separated values",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"sensitive_fields": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Driver config sensitive fields. Password type fields should be set here",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	return s
+}
+
+// validateDriverConfigDriver returns a validation func failing for drivers having dedicated config arguments
+func validateDriverConfigDriver(builtinDrivers []string) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v, ok := val.(string)
+		if !ok || len(v) == 0 {
+			errs = append(errs, fmt.Errorf("%q must be a non empty string", key))
+			return
+		}
+		for _, driver := range builtinDrivers {
+			if strings.EqualFold(v, driver) {
+				errs = append(errs, fmt.Errorf("%q driver %s has dedicated config argument, use it instead", key, v))
+				return
+			}
+		}
+		return
+	}
+}
@@ -0,0 +1,133 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+const (
+	effectivePermissionsScopeGlobal  = "global"
+	effectivePermissionsScopeCluster = "cluster"
+	effectivePermissionsScopeProject = "project"
+
+	effectivePermissionsBindingGlobal  = "globalRoleBinding"
+	effectivePermissionsBindingCluster = "clusterRoleTemplateBinding"
+	effectivePermissionsBindingProject = "projectRoleTemplateBinding"
+
+	effectivePermissionsLocalClusterID = "local"
+
+	effectivePermissionsUserAttributeV2APIType = rancher2ManagementV2TypePrefix + ".userattribute"
+)
+
+var (
+	effectivePermissionsScopes = []string{
+		effectivePermissionsScopeGlobal,
+		effectivePermissionsScopeCluster,
+		effectivePermissionsScopeProject,
+	}
+)
+
+//Types
+
+// effectivePermissionsGrant is the binding granting a rule, and the roles path from the binding to the rule
+type effectivePermissionsGrant struct {
+	BindingType string
+	BindingID   string
+	RolePath    []string
+}
+
+type effectivePermissionsRule struct {
+	Rule      managementClient.PolicyRule
+	GrantedBy []effectivePermissionsGrant
+}
+
+// effectivePermissionsGlobalRoleBinding adds the user principal ID, missing at generated client, to global role bindings
+type effectivePermissionsGlobalRoleBinding struct {
+	managementClient.GlobalRoleBinding
+	UserPrincipalID string `json:"userPrincipalId,omitempty" yaml:"userPrincipalId,omitempty"`
+}
+
+//Schemas
+
+func effectivePermissionsGrantFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"binding_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Binding type granting the rule: globalRoleBinding, clusterRoleTemplateBinding or projectRoleTemplateBinding",
+		},
+		"binding_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Binding ID granting the rule",
+		},
+		"role_path": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Global role and role templates inheritance path from the binding to the rule",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	return s
+}
+
+func effectivePermissionsRuleFields() map[string]*schema.Schema {
+	s := policyRuleFields()
+	s["granted_by"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Bindings granting the rule",
+		Elem: &schema.Resource{
+			Schema: effectivePermissionsGrantFields(),
+		},
+	}
+
+	return s
+}
+
+func effectivePermissionsFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"scope": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(effectivePermissionsScopes, false),
+			Description:  "Permissions scope: global, cluster or project",
+		},
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Cluster ID, required for cluster scope",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Project ID, required for project scope",
+		},
+		"group_principal_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"user_id"},
+			Description:   "Group principal ID to get effective permissions",
+		},
+		"user_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"group_principal_id"},
+			Description:   "User ID to get effective permissions",
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Deduplicated effective policy rules",
+			Elem: &schema.Resource{
+				Schema: effectivePermissionsRuleFields(),
+			},
+		},
+	}
+
+	return s
+}
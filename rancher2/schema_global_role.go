@@ -6,6 +6,26 @@ import (
 
 //Schemas
 
+func globalRoleNamespacedRulesFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"namespace": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Local cluster namespace where rules are granted",
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "Policy rules granted at the namespace",
+			Elem: &schema.Resource{
+				Schema: policyRuleFields(),
+			},
+		},
+	}
+
+	return s
+}
+
 func globalRoleFields() map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"builtin": {
@@ -47,6 +67,14 @@ func globalRoleFields() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"namespaced_rules": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Global role policy rules granted at specific namespaces of the local cluster. Rancher v2.8.0 and above",
+			Elem: &schema.Resource{
+				Schema: globalRoleNamespacedRulesFields(),
+			},
+		},
 	}
 
 	for k, v := range commonAnnotationLabelFields() {
@@ -0,0 +1,91 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	kubeconfigACENodePort     = "6443"
+	kubeconfigTokenDescPrefix = "Terraform kubeconfig token for cluster "
+)
+
+//Types
+
+type kubeconfigNode struct {
+	Name    string
+	Address string
+}
+
+type kubeconfigCluster struct {
+	ID          string
+	Name        string
+	Token       string
+	FQDN        string
+	FQDNCACerts string
+	Nodes       []kubeconfigNode
+	NodesCACert string
+}
+
+//Schemas
+
+func kubeconfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_ids": {
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			MinItems:    1,
+			Description: "Cluster IDs to generate the kubeconfig for. Cluster V2 IDs (<fleet_namespace>/<name>) or cluster V1 IDs",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     0,
+			Description: "Kubeconfig tokens time to live in seconds",
+		},
+		"ace": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Add authorized cluster endpoint (ACE) contexts for clusters with local auth endpoint enabled",
+		},
+		"rotate_before": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDuration,
+			Description:  "Rotate kubeconfig tokens when they expire within this duration, golang duration format",
+		},
+		"renew": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Renew expired, disabled or expiring kubeconfig tokens",
+		},
+		"kube_config": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Generated kubeconfig",
+		},
+		"token_ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Kubeconfig token IDs",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Earliest kubeconfig token expiration date, RFC3339 format",
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,117 @@
+package rancher2
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	norman "github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	listClusterV2APIType           = rancher2ManagementV2TypePrefix + ".cluster"
+	listProjectV2APIType           = rancher2ManagementV2TypePrefix + ".project"
+	listNodePoolV2APIType          = rancher2ManagementV2TypePrefix + ".nodepool"
+	listUserV2APIType              = rancher2ManagementV2TypePrefix + ".user"
+	listNamespaceV2APIType         = "namespace"
+	listNamespaceProjectIDLabelKey = "field.cattle.io/projectId"
+)
+
+//Types
+
+// listItem is the common information returned by plural data sources for every object
+type listItem struct {
+	ID          string
+	Name        string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// listItemV2 is a Rancher v2 API object, decoding the fields used by plural data sources
+type listItemV2 struct {
+	norman.Resource
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              listItemV2Spec `json:"spec,omitempty"`
+	DisplayName       string         `json:"displayName,omitempty"`
+	Username          string         `json:"username,omitempty"`
+}
+
+type listItemV2Spec struct {
+	DisplayName      string `json:"displayName,omitempty"`
+	NodeTemplateName string `json:"nodeTemplateName,omitempty"`
+}
+
+// rawObjectCollection is a page of any API objects, kept undecoded
+type rawObjectCollection struct {
+	norman.Collection
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+//Schemas
+
+func listItemFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"annotations": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+	}
+
+	return s
+}
+
+// listFields returns the common filters and outputs of plural data sources, listing objects at itemsKey
+func listFields(itemsKey string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			Description:  "Regular expression to filter objects by name",
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Labels to filter objects. Objects should have all of them",
+		},
+		"ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Found object IDs",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Found object names",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		itemsKey: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Found objects",
+			Elem: &schema.Resource{
+				Schema: listItemFields(),
+			},
+		},
+	}
+
+	return s
+}
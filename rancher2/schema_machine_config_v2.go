@@ -8,9 +8,13 @@ var allMachineDriverConfigFields = []string{
 	"amazonec2_config",
 	"azure_config",
 	"digitalocean_config",
+	"generic_config",
 	"harvester_config",
+	"hetzner_config",
 	"linode_config",
+	"opennebula_config",
 	"openstack_config",
+	"outscale_config",
 	"vsphere_config",
 }
 
@@ -57,6 +61,16 @@ func machineConfigV2Fields() map[string]*schema.Schema {
 				Schema: machineConfigV2DigitaloceanFields(),
 			},
 		},
+		"generic_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allMachineDriverConfigFields, "generic_config"),
+			Description:   "Machine config for node drivers without dedicated argument. Fields are validated against the driver machine config schema",
+			Elem: &schema.Resource{
+				Schema: machineConfigV2GenericFields(),
+			},
+		},
 		"kind": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -74,6 +88,15 @@ func machineConfigV2Fields() map[string]*schema.Schema {
 				Schema: machineConfigV2HarvesterFields(),
 			},
 		},
+		"hetzner_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allMachineDriverConfigFields, "hetzner_config"),
+			Elem: &schema.Resource{
+				Schema: machineConfigV2HetznerFields(),
+			},
+		},
 		"linode_config": {
 			Type:          schema.TypeList,
 			MaxItems:      1,
@@ -83,6 +106,15 @@ func machineConfigV2Fields() map[string]*schema.Schema {
 				Schema: machineConfigV2LinodeFields(),
 			},
 		},
+		"opennebula_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allMachineDriverConfigFields, "opennebula_config"),
+			Elem: &schema.Resource{
+				Schema: machineConfigV2OpennebulaFields(),
+			},
+		},
 		"openstack_config": {
 			Type:          schema.TypeList,
 			MaxItems:      1,
@@ -92,6 +124,15 @@ func machineConfigV2Fields() map[string]*schema.Schema {
 				Schema: machineConfigV2OpenstackFields(),
 			},
 		},
+		"outscale_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allMachineDriverConfigFields, "outscale_config"),
+			Elem: &schema.Resource{
+				Schema: machineConfigV2OutscaleFields(),
+			},
+		},
 		"vsphere_config": {
 			Type:          schema.TypeList,
 			MaxItems:      1,
@@ -0,0 +1,27 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var (
+	// machineConfigV2BuiltinDrivers are the drivers with dedicated machine config V2 arguments
+	machineConfigV2BuiltinDrivers = []string{
+		"amazonec2",
+		"azure",
+		"digitalocean",
+		"harvester",
+		"hetzner",
+		"linode",
+		"opennebula",
+		"openstack",
+		"outscale",
+		"vmwarevsphere",
+	}
+)
+
+//Schemas
+
+func machineConfigV2GenericFields() map[string]*schema.Schema {
+	return driverConfigFields(validateDriverConfigDriver(machineConfigV2BuiltinDrivers))
+}
@@ -0,0 +1,70 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+//Schemas
+
+func machineConfigV2HetznerFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"api_token": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Hetzner Cloud project API token",
+		},
+		"image": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "ubuntu-18.04",
+			Description: "Hetzner Cloud server image",
+		},
+		"networks": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Network IDs or names which should be attached to the server private network interface",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"server_labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Map of the labels which will be assigned to the server",
+		},
+		"server_location": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "nbg1",
+			Description: "Hetzner Cloud datacenter",
+		},
+		"server_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "cx11",
+			Description: "Hetzner Cloud server type",
+		},
+		"use_private_network": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Use private network",
+		},
+		"userdata": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Cloud-init user-data",
+		},
+		"volumes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Volume IDs or names which should be attached to the server",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	return s
+}
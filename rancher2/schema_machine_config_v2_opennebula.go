@@ -0,0 +1,112 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+//Schemas
+
+func machineConfigV2OpennebulaFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"b2d_size": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Size of the Volatile disk in MB (only for b2d)",
+		},
+		"cpu": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "CPU value for the VM",
+		},
+		"dev_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Dev prefix to use for the images: 'vd', 'sd', 'hd', etc...",
+		},
+		"disable_vnc": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "VNC is enabled by default. Disable it with this flag",
+		},
+		"disk_resize": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Size of the disk for the VM in MB",
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Image ID to use as the OS",
+		},
+		"image_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Image to use as the OS",
+		},
+		"image_owner": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Owner of the image to use as the OS",
+		},
+		"memory": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Size of the memory for the VM in MB",
+		},
+		"network_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Network ID to connect the machine to",
+		},
+		"network_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Network to connect the machine to",
+		},
+		"network_owner": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "User ID of the Network to connect the machine to",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Set the password for the XML-RPC API authentication",
+		},
+		"ssh_user": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "docker",
+			Description: "Set the name of the SSH user",
+		},
+		"template_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Template ID to use",
+		},
+		"template_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Template to use",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Set the user for the XML-RPC API authentication",
+		},
+		"vcpu": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "VCPUs for the VM",
+		},
+		"xml_rpc_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Set the url for the Opennebula XML-RPC API",
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,83 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+//Schemas
+
+func machineConfigV2OutscaleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"access_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Outscale Access Key",
+		},
+		"extra_tags_all": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Extra tags for all created resources (e.g. key1=value1,key2=value2)",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"extra_tags_instances": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Extra tags only for instances (e.g. key1=value1,key2=value2)",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"instance_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "tinav2.c1r2p3",
+			Description: "Outscale VM type",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "eu-west-2",
+			Description: "Outscale Region",
+		},
+		"root_disk_iops": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Iops for io1 Root Disk. From 1 to 13000.",
+		},
+		"root_disk_size": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Size of the Root Disk (in GB). From 1 to 14901.",
+		},
+		"root_disk_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Type of the Root Disk. Possible values are :'standard', 'gp2' or 'io1'.",
+		},
+		"secret_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Outscale Secret Key",
+		},
+		"security_group_ids": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Ids of user defined Security Groups to add to the machine",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"source_omi": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "ami-2cf1fa3e",
+			Description: "Outscale Machine Image to use as bootstrap for the VM",
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,150 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+const (
+	membersClusterOwnerRoleTemplateID = "cluster-owner"
+	membersProjectOwnerRoleTemplateID = "project-owner"
+)
+
+//Types
+
+// roleTemplateBindingMember is a cluster or project role template binding, independently of its scope
+type roleTemplateBindingMember struct {
+	BindingID        string
+	RoleTemplateID   string
+	UserID           string
+	UserPrincipalID  string
+	GroupID          string
+	GroupPrincipalID string
+}
+
+// roleTemplateBindingMembersExclusion defines the bindings that aren't managed by the members resources
+type roleTemplateBindingMembersExclusion struct {
+	Owner           bool
+	PrincipalIDs    []string
+	RoleTemplateIDs []string
+}
+
+// roleTemplateBindingMembersScope defines the cluster or project specific operations of the members resources
+type roleTemplateBindingMembersScope struct {
+	Name                string
+	OwnerRoleTemplateID string
+	// List returns the role template bindings of the scope id as members
+	List func(c *Config, id string) ([]roleTemplateBindingMember, error)
+	// Create creates a role template binding for member at the scope id, returning the binding ID
+	Create func(client *managementClient.Client, id string, member roleTemplateBindingMember) (string, error)
+	// Delete deletes the role template binding id, if it exists
+	Delete func(client *managementClient.Client, id string) error
+	// Refresh returns the role template binding id state refresh func
+	Refresh func(client *managementClient.Client, id string) resource.StateRefreshFunc
+}
+
+//Schemas
+
+func membersMemberFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"role_template_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role template ID to bind",
+		},
+		"group_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Group ID to bind",
+		},
+		"group_principal_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Group principal ID to bind",
+		},
+		"user_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "User ID to bind",
+		},
+		"user_principal_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "User principal ID to bind",
+		},
+	}
+
+	return s
+}
+
+func membersFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"member": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Complete set of role template bindings. Bindings not defined here are removed, unless excluded",
+			Elem: &schema.Resource{
+				Schema: membersMemberFields(),
+			},
+		},
+		"exclude_owner": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Don't manage owner role template bindings",
+		},
+		"excluded_principal_ids": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "User IDs, group IDs or principal IDs whose bindings aren't managed",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"excluded_role_template_ids": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Role template IDs whose bindings aren't managed",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	return s
+}
+
+func clusterMembersFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Cluster ID to manage members",
+		},
+	}
+
+	for k, v := range membersFields() {
+		s[k] = v
+	}
+
+	return s
+}
+
+func projectMembersFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"project_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Project ID to manage members",
+		},
+	}
+
+	for k, v := range membersFields() {
+		s[k] = v
+	}
+
+	return s
+}
@@ -0,0 +1,82 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	nodeStateActive   = "active"
+	nodeStateCordoned = "cordoned"
+	nodeStateDraining = "draining"
+	nodeStateDrained  = "drained"
+	nodeStateRemoving = "removing"
+	nodeStateRemoved  = "removed"
+)
+
+//Schemas
+
+func nodeFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Cluster V1 ID of the node",
+		},
+		"node_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Kubernetes name of the node",
+		},
+		"annotations": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Node annotations managed by Terraform. Other node annotations are kept",
+		},
+		"cordoned": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Cordon the node",
+		},
+		"drain_input": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "Node drain options",
+			Elem: &schema.Resource{
+				Schema: clusterRKEConfigNodeDrainInputFields(),
+			},
+		},
+		"drained": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Drain the node. Requires cordoned to be true",
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Node labels managed by Terraform. Other node labels are kept",
+		},
+		"taints": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Node taints managed by Terraform, identified by key and effect. Other node taints are kept",
+			Elem: &schema.Resource{
+				Schema: taintFields(),
+			},
+		},
+		"hostname": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return s
+}
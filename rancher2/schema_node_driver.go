@@ -40,6 +40,12 @@ func nodeDriverFields() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"validate_url": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Validate at plan that url uses https and its host is covered by whitelist_domains",
+		},
 		"whitelist_domains": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -3,10 +3,55 @@ package rancher2
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 )
 
+//Types
+
+type nodePoolRollingReplace struct {
+	Drain          bool
+	DrainInput     *managementClient.NodeDrainInput
+	MaxSurge       int
+	MaxUnavailable int
+}
+
 //Schemas
 
+func nodePoolRollingReplaceFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"drain": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Drain the old nodes before deleting them",
+		},
+		"drain_input": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: clusterRKEConfigNodeDrainInputFields(),
+			},
+		},
+		"max_surge": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Number of new nodes created above the pool quantity during the replacement",
+		},
+		"max_unavailable": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Number of nodes that can be unavailable below the pool quantity during the replacement",
+		},
+	}
+
+	return s
+}
+
 func nodePoolFields() map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"cluster_id": {
@@ -58,6 +103,15 @@ func nodePoolFields() map[string]*schema.Schema {
 			Default:      1,
 			ValidateFunc: validation.IntAtLeast(1),
 		},
+		"rolling_replace": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "Replace the pool nodes in batches when node_template_id is updated",
+			Elem: &schema.Resource{
+				Schema: nodePoolRollingReplaceFields(),
+			},
+		},
 		"worker": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -1,6 +1,9 @@
 package rancher2
 
 import (
+	"encoding/json"
+	"slices"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 )
@@ -9,24 +12,87 @@ import (
 
 type NodeTemplate struct {
 	managementClient.NodeTemplate
-	Amazonec2Config     *amazonec2Config     `json:"amazonec2Config,omitempty" yaml:"amazonec2Config,omitempty"`
-	AzureConfig         *azureConfig         `json:"azureConfig,omitempty" yaml:"azureConfig,omitempty"`
-	DigitaloceanConfig  *digitaloceanConfig  `json:"digitaloceanConfig,omitempty" yaml:"digitaloceanConfig,omitempty"`
-	HarvesterConfig     *harvesterConfig     `json:"harvesterConfig,omitempty" yaml:"harvesterConfig,omitempty"`
-	HetznerConfig       *hetznerConfig       `json:"hetznerConfig,omitempty" yaml:"hetznerConfig,omitempty"`
-	LinodeConfig        *linodeConfig        `json:"linodeConfig,omitempty" yaml:"linodeConfig,omitempty"`
-	OpennebulaConfig    *opennebulaConfig    `json:"opennebulaConfig,omitempty" yaml:"opennebulaConfig,omitempty"`
-	OpenstackConfig     *openstackConfig     `json:"openstackConfig,omitempty" yaml:"openstackConfig,omitempty"`
-	VmwarevsphereConfig *vmwarevsphereConfig `json:"vmwarevsphereConfig,omitempty" yaml:"vmwarevsphereConfig,omitempty"`
-	OutscaleConfig      *outscaleConfig      `json:"outscaleConfig,omitempty" yaml:"outscaleConfig,omitempty"`
+	Amazonec2Config     *amazonec2Config       `json:"amazonec2Config,omitempty" yaml:"amazonec2Config,omitempty"`
+	AzureConfig         *azureConfig           `json:"azureConfig,omitempty" yaml:"azureConfig,omitempty"`
+	DigitaloceanConfig  *digitaloceanConfig    `json:"digitaloceanConfig,omitempty" yaml:"digitaloceanConfig,omitempty"`
+	HarvesterConfig     *harvesterConfig       `json:"harvesterConfig,omitempty" yaml:"harvesterConfig,omitempty"`
+	HetznerConfig       *hetznerConfig         `json:"hetznerConfig,omitempty" yaml:"hetznerConfig,omitempty"`
+	LinodeConfig        *linodeConfig          `json:"linodeConfig,omitempty" yaml:"linodeConfig,omitempty"`
+	OpennebulaConfig    *opennebulaConfig      `json:"opennebulaConfig,omitempty" yaml:"opennebulaConfig,omitempty"`
+	OpenstackConfig     *openstackConfig       `json:"openstackConfig,omitempty" yaml:"openstackConfig,omitempty"`
+	VmwarevsphereConfig *vmwarevsphereConfig   `json:"vmwarevsphereConfig,omitempty" yaml:"vmwarevsphereConfig,omitempty"`
+	OutscaleConfig      *outscaleConfig        `json:"outscaleConfig,omitempty" yaml:"outscaleConfig,omitempty"`
+	DriverConfig        map[string]interface{} `json:"-" yaml:"-"`
+}
+
+// MarshalJSON adds DriverConfig as <driver>Config field, for drivers without dedicated config
+func (n NodeTemplate) MarshalJSON() ([]byte, error) {
+	// Avoiding MarshalJSON recursion
+	type nodeTemplate NodeTemplate
+	out, err := json.Marshal(nodeTemplate(n))
+	if err != nil || n.DriverConfig == nil || len(n.Driver) == 0 {
+		return out, err
+	}
+	obj := map[string]interface{}{}
+	err = json.Unmarshal(out, &obj)
+	if err != nil {
+		return nil, err
+	}
+	obj[n.Driver+nodeTemplateDriverConfigSuffix] = n.DriverConfig
+
+	return json.Marshal(obj)
 }
 
+// UnmarshalJSON sets DriverConfig from <driver>Config field, for drivers without dedicated config
+func (n *NodeTemplate) UnmarshalJSON(data []byte) error {
+	// Avoiding UnmarshalJSON recursion
+	type nodeTemplate NodeTemplate
+	obj := nodeTemplate{}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	*n = NodeTemplate(obj)
+	if len(n.Driver) == 0 || slices.Contains(nodeTemplateBuiltinDrivers, n.Driver) {
+		return nil
+	}
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	if v, ok := fields[n.Driver+nodeTemplateDriverConfigSuffix]; ok {
+		return json.Unmarshal(v, &n.DriverConfig)
+	}
+
+	return nil
+}
+
+const nodeTemplateDriverConfigSuffix = "Config"
+
+var (
+	// nodeTemplateBuiltinDrivers are the drivers with dedicated node template config arguments
+	nodeTemplateBuiltinDrivers = []string{
+		amazonec2ConfigDriver,
+		azureConfigDriver,
+		digitaloceanConfigDriver,
+		harvesterConfigDriver,
+		hetznerConfigDriver,
+		linodeConfigDriver,
+		opennebulaConfigDriver,
+		openstackConfigDriver,
+		outscaleConfigDriver,
+		vmwarevsphereConfigDriver,
+	}
+)
+
 //Schemas
 
 var allNodeTemplateDriverConfigFields = []string{
 	"amazonec2_config",
 	"azure_config",
 	"digitalocean_config",
+	"driver_config",
 	"harvester_config",
 	"hetzner_config",
 	"linode_config",
@@ -100,6 +166,16 @@ func nodeTemplateFields() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"driver_config": {
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: getConflicts(allNodeTemplateDriverConfigFields, "driver_config"),
+			Description:   "Node template config for node drivers without dedicated argument. Fields are validated against the driver config schema",
+			Elem: &schema.Resource{
+				Schema: driverConfigFields(validateDriverConfigDriver(nodeTemplateBuiltinDrivers)),
+			},
+		},
 		"driver_id": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -0,0 +1,86 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+//Schemas
+
+func principalsPrincipalFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Principal ID",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Principal display name",
+		},
+		"login_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Principal login name",
+		},
+		"provider": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Principal auth provider",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Principal type: user or group",
+		},
+	}
+
+	return s
+}
+
+func principalsFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Principal name or login name prefix to search",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(principalTypes, true),
+			Description:  "Principal type to search: user or group. All types if empty",
+		},
+		"auth_provider": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Auth provider to filter principals, ex: activedirectory, azuread, openldap",
+		},
+		"limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Maximum number of principals to return. Unlimited if 0",
+		},
+		"ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Found principal IDs",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"principals": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Found principals",
+			Elem: &schema.Resource{
+				Schema: principalsPrincipalFields(),
+			},
+		},
+	}
+
+	return s
+}
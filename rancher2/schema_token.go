@@ -50,7 +50,7 @@ func tokenFields() map[string]*schema.Schema {
 			Optional:    true,
 			ForceNew:    true,
 			Default:     true,
-			Description: "Renew expired or disabled token",
+			Description: "Renew expired, disabled or expiring token",
 		},
 		"secret_key": {
 			Type:        schema.TypeString,
@@ -78,9 +78,36 @@ func tokenFields() map[string]*schema.Schema {
 		},
 	}
 
+	for k, v := range tokenRotationFields() {
+		s[k] = v
+	}
 	for k, v := range commonAnnotationLabelFields() {
 		s[k] = v
 	}
 
 	return s
 }
+
+func tokenRotationFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Token expiration date, RFC3339 format",
+		},
+		"rotate_before": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDuration,
+			Description:  "Renew token when it expires within this duration, golang duration format",
+		},
+		"rotation_triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary map of values that, when changed, will force token rotation",
+		},
+	}
+
+	return s
+}
@@ -4,19 +4,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+const (
+	userLocalPrincipalPrefix       = "local://"
+	userPasswordMinLengthSettingID = "password-min-length"
+	userPasswordMinLengthDefault   = 12
+)
+
 //Schemas
 
 func userFields() map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"password": {
-			Type:      schema.TypeString,
-			Required:  true,
-			Sensitive: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "User password. Required for local users, a random one is set if empty for external principal users",
 		},
 		"username": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "User username. Required to create users",
+		},
+		"principal_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "External principal ID to create the user for, ex: activedirectory_user://CN=foo,OU=users,DC=example,DC=com",
+		},
+		"refresh_auth_provider_access": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Refresh the user group memberships from the auth provider on every create and update",
+		},
+		"refresh_triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Arbitrary map of values that, when changed, will refresh the user group memberships. Requires refresh_auth_provider_access",
 		},
 		"enabled": {
 			Type:     schema.TypeBool,
@@ -15,14 +15,16 @@ func flattenAuthConfigADFS(d *schema.ResourceData, in *managementClient.ADFSConf
 	d.Set("type", managementClient.ADFSConfigType)
 	d.Set("access_mode", in.AccessMode)
 
-	err := d.Set("allowed_principal_ids", toArrayInterface(in.AllowedPrincipalIDs))
+	// Allowed principals managed by rancher2_auth_config_allowed_principal resources are ignored
+	allowedPrincipalIDs, annotations := flattenAuthConfigAllowedPrincipals(in.AllowedPrincipalIDs, in.Annotations)
+	err := d.Set("allowed_principal_ids", allowedPrincipalIDs)
 	if err != nil {
 		return err
 	}
 
 	d.Set("enabled", in.Enabled)
 
-	err = d.Set("annotations", toMapInterface(in.Annotations))
+	err = d.Set("annotations", annotations)
 	if err != nil {
 		return err
 	}
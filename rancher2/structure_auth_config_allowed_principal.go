@@ -0,0 +1,123 @@
+package rancher2
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Flatteners
+
+// flattenAuthConfigAllowedPrincipals returns allowed principal IDs and annotations without the ones managed by
+// rancher2_auth_config_allowed_principal resources
+func flattenAuthConfigAllowedPrincipals(ids []string, annotations map[string]string) ([]interface{}, map[string]interface{}) {
+	managed := getAuthConfigManagedPrincipalIDs(annotations)
+
+	outIDs := []interface{}{}
+	for _, id := range ids {
+		if !slices.Contains(managed, id) {
+			outIDs = append(outIDs, id)
+		}
+	}
+	outAnnotations := map[string]interface{}{}
+	for k, v := range annotations {
+		if k != authConfigManagedPrincipalIDsAnnotation {
+			outAnnotations[k] = v
+		}
+	}
+
+	return outIDs, outAnnotations
+}
+
+// Expanders
+
+// expandAuthConfigAllowedPrincipals adds the allowed principal IDs managed by rancher2_auth_config_allowed_principal
+// resources, from remote annotations, to ids and annotations
+func expandAuthConfigAllowedPrincipals(remote map[string]string, ids []string, annotations map[string]string) ([]string, map[string]string) {
+	v, ok := remote[authConfigManagedPrincipalIDsAnnotation]
+	if !ok {
+		return ids, annotations
+	}
+
+	for _, id := range getAuthConfigManagedPrincipalIDs(remote) {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[authConfigManagedPrincipalIDsAnnotation] = v
+
+	return ids, annotations
+}
+
+// expandAuthConfigAllowedPrincipal adds or removes principalID to the allowed principal IDs and managed annotation
+// of the auth config V2 raw object, keeping its metadata resourceVersion. It returns true if obj was modified
+func expandAuthConfigAllowedPrincipal(obj map[string]interface{}, principalID string, allowed bool) (bool, error) {
+	ids := []string{}
+	if v, ok := obj["allowedPrincipalIds"].([]interface{}); ok {
+		ids = toArrayString(v)
+	}
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok || metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	annotations := map[string]string{}
+	if v, ok := metadata["annotations"].(map[string]interface{}); ok {
+		annotations = toMapString(v)
+	}
+	managed := getAuthConfigManagedPrincipalIDs(annotations)
+
+	changed := false
+	if allowed {
+		if !slices.Contains(ids, principalID) {
+			ids = append(ids, principalID)
+			changed = true
+		}
+		if !slices.Contains(managed, principalID) {
+			managed = append(managed, principalID)
+			changed = true
+		}
+	} else {
+		if slices.Contains(ids, principalID) {
+			ids = slices.DeleteFunc(ids, func(id string) bool { return id == principalID })
+			changed = true
+		}
+		if slices.Contains(managed, principalID) {
+			managed = slices.DeleteFunc(managed, func(id string) bool { return id == principalID })
+			changed = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	if len(managed) > 0 {
+		value, err := json.Marshal(managed)
+		if err != nil {
+			return false, err
+		}
+		annotations[authConfigManagedPrincipalIDsAnnotation] = string(value)
+	} else {
+		delete(annotations, authConfigManagedPrincipalIDsAnnotation)
+	}
+	obj["allowedPrincipalIds"] = toArrayInterface(ids)
+	metadata["annotations"] = toMapInterface(annotations)
+	obj["metadata"] = metadata
+
+	return true, nil
+}
+
+func getAuthConfigManagedPrincipalIDs(annotations map[string]string) []string {
+	out := []string{}
+	v, ok := annotations[authConfigManagedPrincipalIDsAnnotation]
+	if !ok || len(v) == 0 {
+		return out
+	}
+	// Malformed annotation is considered empty
+	if err := json.Unmarshal([]byte(v), &out); err != nil {
+		return []string{}
+	}
+
+	return out
+}
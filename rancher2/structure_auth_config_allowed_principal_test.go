@@ -0,0 +1,166 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAuthConfigManagedPrincipalIDs = `["github_team://2","github_user://3"]`
+)
+
+func TestFlattenAuthConfigAllowedPrincipals(t *testing.T) {
+
+	cases := []struct {
+		IDs                 []string
+		Annotations         map[string]string
+		ExpectedIDs         []interface{}
+		ExpectedAnnotations map[string]interface{}
+	}{
+		{
+			[]string{"github_user://1", "github_team://2"},
+			map[string]string{"foo": "bar"},
+			[]interface{}{"github_user://1", "github_team://2"},
+			map[string]interface{}{"foo": "bar"},
+		},
+		{
+			[]string{"github_user://1", "github_team://2", "github_user://3"},
+			map[string]string{
+				"foo":                                   "bar",
+				authConfigManagedPrincipalIDsAnnotation: testAuthConfigManagedPrincipalIDs,
+			},
+			[]interface{}{"github_user://1"},
+			map[string]interface{}{"foo": "bar"},
+		},
+	}
+
+	for _, tc := range cases {
+		ids, annotations := flattenAuthConfigAllowedPrincipals(tc.IDs, tc.Annotations)
+		assert.Equal(t, tc.ExpectedIDs, ids, "Unexpected allowed principal IDs from flattener.")
+		assert.Equal(t, tc.ExpectedAnnotations, annotations, "Unexpected annotations from flattener.")
+	}
+}
+
+func TestExpandAuthConfigAllowedPrincipals(t *testing.T) {
+
+	cases := []struct {
+		Remote              map[string]string
+		IDs                 []string
+		Annotations         map[string]string
+		ExpectedIDs         []string
+		ExpectedAnnotations map[string]string
+	}{
+		{
+			nil,
+			[]string{"github_user://1"},
+			nil,
+			[]string{"github_user://1"},
+			nil,
+		},
+		{
+			map[string]string{
+				authConfigManagedPrincipalIDsAnnotation: testAuthConfigManagedPrincipalIDs,
+			},
+			[]string{"github_user://1", "github_team://2"},
+			map[string]string{"foo": "bar"},
+			[]string{"github_user://1", "github_team://2", "github_user://3"},
+			map[string]string{
+				"foo":                                   "bar",
+				authConfigManagedPrincipalIDsAnnotation: testAuthConfigManagedPrincipalIDs,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		ids, annotations := expandAuthConfigAllowedPrincipals(tc.Remote, tc.IDs, tc.Annotations)
+		assert.Equal(t, tc.ExpectedIDs, ids, "Unexpected allowed principal IDs from expander.")
+		assert.Equal(t, tc.ExpectedAnnotations, annotations, "Unexpected annotations from expander.")
+	}
+}
+
+func TestExpandAuthConfigAllowedPrincipal(t *testing.T) {
+
+	cases := []struct {
+		Input           map[string]interface{}
+		PrincipalID     string
+		Allowed         bool
+		ExpectedChanged bool
+		ExpectedOutput  map[string]interface{}
+	}{
+		{
+			map[string]interface{}{
+				"accessMode":          "restricted",
+				"allowedPrincipalIds": []interface{}{"github_user://1"},
+			},
+			"github_user://3",
+			true,
+			true,
+			map[string]interface{}{
+				"accessMode":          "restricted",
+				"allowedPrincipalIds": []interface{}{"github_user://1", "github_user://3"},
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{
+						authConfigManagedPrincipalIDsAnnotation: `["github_user://3"]`,
+					},
+				},
+			},
+		},
+		{
+			map[string]interface{}{
+				"allowedPrincipalIds": []interface{}{"github_user://1", "github_team://2", "github_user://3"},
+				"metadata": map[string]interface{}{
+					"resourceVersion": "1234",
+					"annotations": map[string]interface{}{
+						authConfigManagedPrincipalIDsAnnotation: testAuthConfigManagedPrincipalIDs,
+					},
+				},
+			},
+			"github_user://3",
+			true,
+			false,
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"allowedPrincipalIds": []interface{}{"github_user://1", "github_team://2", "github_user://3"},
+				"metadata": map[string]interface{}{
+					"resourceVersion": "1234",
+					"annotations": map[string]interface{}{
+						authConfigManagedPrincipalIDsAnnotation: testAuthConfigManagedPrincipalIDs,
+					},
+				},
+			},
+			"github_team://2",
+			false,
+			true,
+			map[string]interface{}{
+				"allowedPrincipalIds": []interface{}{"github_user://1", "github_user://3"},
+				"metadata": map[string]interface{}{
+					"resourceVersion": "1234",
+					"annotations": map[string]interface{}{
+						authConfigManagedPrincipalIDsAnnotation: `["github_user://3"]`,
+					},
+				},
+			},
+		},
+		{
+			map[string]interface{}{
+				"allowedPrincipalIds": []interface{}{"github_user://1"},
+			},
+			"github_user://3",
+			false,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		changed, err := expandAuthConfigAllowedPrincipal(tc.Input, tc.PrincipalID, tc.Allowed)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedChanged, changed, "Unexpected change from expander.")
+		if tc.ExpectedChanged {
+			assert.Equal(t, tc.ExpectedOutput, tc.Input, "Unexpected output from expander.")
+		}
+	}
+}
@@ -20,6 +20,10 @@ func flattenCloudCredential(d *schema.ResourceData, in *CloudCredential) error {
 	}
 
 	driver := d.Get("driver").(string)
+	if len(driver) == 0 && len(in.Driver) > 0 {
+		driver = in.Driver
+		d.Set("driver", driver)
+	}
 	switch driver {
 	case amazonec2ConfigDriver:
 		v, ok := d.Get("amazonec2_credential_config").([]interface{})
@@ -66,6 +70,15 @@ func flattenCloudCredential(d *schema.ResourceData, in *CloudCredential) error {
 		if err != nil {
 			return err
 		}
+	case hetznerConfigDriver:
+		v, ok := d.Get("hetzner_credential_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("hetzner_credential_config", flattenCloudCredentialHetzner(in.HetznerCredentialConfig, v))
+		if err != nil {
+			return err
+		}
 	case linodeConfigDriver:
 		v, ok := d.Get("linode_credential_config").([]interface{})
 		if !ok {
@@ -75,6 +88,15 @@ func flattenCloudCredential(d *schema.ResourceData, in *CloudCredential) error {
 		if err != nil {
 			return err
 		}
+	case opennebulaConfigDriver:
+		v, ok := d.Get("opennebula_credential_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("opennebula_credential_config", flattenCloudCredentialOpennebula(in.OpennebulaCredentialConfig, v))
+		if err != nil {
+			return err
+		}
 	case openstackConfigDriver:
 		v, ok := d.Get("openstack_credential_config").([]interface{})
 		if !ok {
@@ -84,6 +106,15 @@ func flattenCloudCredential(d *schema.ResourceData, in *CloudCredential) error {
 		if err != nil {
 			return err
 		}
+	case outscaleConfigDriver:
+		v, ok := d.Get("outscale_credential_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("outscale_credential_config", flattenCloudCredentialOutscale(in.OutscaleCredentialConfig, v))
+		if err != nil {
+			return err
+		}
 	case s3ConfigDriver:
 		v, ok := d.Get("s3_credential_config").([]interface{})
 		if !ok {
@@ -103,7 +134,14 @@ func flattenCloudCredential(d *schema.ResourceData, in *CloudCredential) error {
 			return err
 		}
 	default:
-		return fmt.Errorf("[ERROR] Unsupported driver on cloud credential: %s", driver)
+		if in.DriverConfig == nil || in.Driver != driver {
+			return fmt.Errorf("[ERROR] Unsupported driver on cloud credential: %s", driver)
+		}
+		v, _ := d.Get("driver_config").([]interface{})
+		err := d.Set("driver_config", flattenDriverConfig(in.Driver, in.DriverConfig, v))
+		if err != nil {
+			return err
+		}
 	}
 
 	if in.Annotations != nil {
@@ -155,6 +193,13 @@ func expandCloudCredential(in *schema.ResourceData) *CloudCredential {
 		in.Set("driver", digitaloceanConfigDriver)
 	}
 
+	if v, ok := in.Get("driver_config").([]interface{}); ok && len(v) > 0 {
+		driver, fields, sensitiveFields := expandDriverConfig(v)
+		obj.DriverConfig = mergeDriverConfigFields(fields, sensitiveFields)
+		obj.Driver = driver
+		in.Set("driver", driver)
+	}
+
 	if v, ok := in.Get("google_credential_config").([]interface{}); ok && len(v) > 0 {
 		obj.GoogleCredentialConfig = expandCloudCredentialGoogle(v)
 		in.Set("driver", googleConfigDriver)
@@ -165,16 +210,31 @@ func expandCloudCredential(in *schema.ResourceData) *CloudCredential {
 		in.Set("driver", harvesterConfigDriver)
 	}
 
+	if v, ok := in.Get("hetzner_credential_config").([]interface{}); ok && len(v) > 0 {
+		obj.HetznerCredentialConfig = expandCloudCredentialHetzner(v)
+		in.Set("driver", hetznerConfigDriver)
+	}
+
 	if v, ok := in.Get("linode_credential_config").([]interface{}); ok && len(v) > 0 {
 		obj.LinodeCredentialConfig = expandCloudCredentialLinode(v)
 		in.Set("driver", linodeConfigDriver)
 	}
 
+	if v, ok := in.Get("opennebula_credential_config").([]interface{}); ok && len(v) > 0 {
+		obj.OpennebulaCredentialConfig = expandCloudCredentialOpennebula(v)
+		in.Set("driver", opennebulaConfigDriver)
+	}
+
 	if v, ok := in.Get("openstack_credential_config").([]interface{}); ok && len(v) > 0 {
 		obj.OpenstackCredentialConfig = expandCloudCredentialOpenstack(v)
 		in.Set("driver", openstackConfigDriver)
 	}
 
+	if v, ok := in.Get("outscale_credential_config").([]interface{}); ok && len(v) > 0 {
+		obj.OutscaleCredentialConfig = expandCloudCredentialOutscale(v)
+		in.Set("driver", outscaleConfigDriver)
+	}
+
 	if v, ok := in.Get("s3_credential_config").([]interface{}); ok && len(v) > 0 {
 		obj.S3CredentialConfig = expandCloudCredentialS3(v)
 		in.Set("driver", s3ConfigDriver)
@@ -0,0 +1,38 @@
+package rancher2
+
+// Flatteners
+
+func flattenCloudCredentialHetzner(in *hetznerCredentialConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.APIToken) > 0 {
+		obj["api_token"] = in.APIToken
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandCloudCredentialHetzner(p []interface{}) *hetznerCredentialConfig {
+	obj := &hetznerCredentialConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["api_token"].(string); ok && len(v) > 0 {
+		obj.APIToken = v
+	}
+
+	return obj
+}
@@ -0,0 +1,59 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testCloudCredentialHetznerConf      *hetznerCredentialConfig
+	testCloudCredentialHetznerInterface []interface{}
+)
+
+func init() {
+	testCloudCredentialHetznerConf = &hetznerCredentialConfig{
+		APIToken: "api_token",
+	}
+	testCloudCredentialHetznerInterface = []interface{}{
+		map[string]interface{}{
+			"api_token": "api_token",
+		},
+	}
+}
+
+func TestFlattenCloudCredentialHetzner(t *testing.T) {
+
+	cases := []struct {
+		Input          *hetznerCredentialConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testCloudCredentialHetznerConf,
+			testCloudCredentialHetznerInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenCloudCredentialHetzner(tc.Input, tc.ExpectedOutput)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandCloudCredentialHetzner(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *hetznerCredentialConfig
+	}{
+		{
+			testCloudCredentialHetznerInterface,
+			testCloudCredentialHetznerConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandCloudCredentialHetzner(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
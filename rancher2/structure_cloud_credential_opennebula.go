@@ -0,0 +1,54 @@
+package rancher2
+
+// Flatteners
+
+func flattenCloudCredentialOpennebula(in *opennebulaCredentialConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.Password) > 0 {
+		obj["password"] = in.Password
+	}
+
+	if len(in.User) > 0 {
+		obj["user"] = in.User
+	}
+
+	if len(in.XMLRPCURL) > 0 {
+		obj["xml_rpc_url"] = in.XMLRPCURL
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandCloudCredentialOpennebula(p []interface{}) *opennebulaCredentialConfig {
+	obj := &opennebulaCredentialConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["password"].(string); ok && len(v) > 0 {
+		obj.Password = v
+	}
+
+	if v, ok := in["user"].(string); ok && len(v) > 0 {
+		obj.User = v
+	}
+
+	if v, ok := in["xml_rpc_url"].(string); ok && len(v) > 0 {
+		obj.XMLRPCURL = v
+	}
+
+	return obj
+}
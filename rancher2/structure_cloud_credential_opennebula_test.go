@@ -0,0 +1,63 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testCloudCredentialOpennebulaConf      *opennebulaCredentialConfig
+	testCloudCredentialOpennebulaInterface []interface{}
+)
+
+func init() {
+	testCloudCredentialOpennebulaConf = &opennebulaCredentialConfig{
+		Password:  "password",
+		User:      "user",
+		XMLRPCURL: "xml_rpc_url",
+	}
+	testCloudCredentialOpennebulaInterface = []interface{}{
+		map[string]interface{}{
+			"password":    "password",
+			"user":        "user",
+			"xml_rpc_url": "xml_rpc_url",
+		},
+	}
+}
+
+func TestFlattenCloudCredentialOpennebula(t *testing.T) {
+
+	cases := []struct {
+		Input          *opennebulaCredentialConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testCloudCredentialOpennebulaConf,
+			testCloudCredentialOpennebulaInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenCloudCredentialOpennebula(tc.Input, tc.ExpectedOutput)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandCloudCredentialOpennebula(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *opennebulaCredentialConfig
+	}{
+		{
+			testCloudCredentialOpennebulaInterface,
+			testCloudCredentialOpennebulaConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandCloudCredentialOpennebula(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
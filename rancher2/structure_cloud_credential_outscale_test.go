@@ -0,0 +1,61 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testCloudCredentialOutscaleConf      *outscaleCredentialConfig
+	testCloudCredentialOutscaleInterface []interface{}
+)
+
+func init() {
+	testCloudCredentialOutscaleConf = &outscaleCredentialConfig{
+		AccessKey: "access_key",
+		SecretKey: "secret_key",
+	}
+	testCloudCredentialOutscaleInterface = []interface{}{
+		map[string]interface{}{
+			"access_key": "access_key",
+			"secret_key": "secret_key",
+		},
+	}
+}
+
+func TestFlattenCloudCredentialOutscale(t *testing.T) {
+
+	cases := []struct {
+		Input          *outscaleCredentialConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testCloudCredentialOutscaleConf,
+			testCloudCredentialOutscaleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenCloudCredentialOutscale(tc.Input, tc.ExpectedOutput)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandCloudCredentialOutscale(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *outscaleCredentialConfig
+	}{
+		{
+			testCloudCredentialOutscaleInterface,
+			testCloudCredentialOutscaleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandCloudCredentialOutscale(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
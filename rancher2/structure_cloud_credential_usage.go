@@ -0,0 +1,122 @@
+package rancher2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+// Flatteners
+
+func flattenCloudCredentialUsage(d *schema.ResourceData, in *cloudCredentialUsage) error {
+	if in == nil {
+		in = &cloudCredentialUsage{}
+	}
+
+	pools := make([]interface{}, 0, len(in.MachinePools))
+	for _, pool := range in.MachinePools {
+		pools = append(pools, map[string]interface{}{
+			"cluster_id": pool.ClusterID,
+			"name":       pool.Name,
+		})
+	}
+	err := d.Set("used_by_machine_pools", pools)
+	if err != nil {
+		return err
+	}
+	err = d.Set("used_by_node_template_ids", toArrayInterface(in.NodeTemplateIDs))
+	if err != nil {
+		return err
+	}
+
+	return d.Set("used_by_etcd_s3_cluster_ids", toArrayInterface(in.EtcdS3ClusterIDs))
+}
+
+// Expanders
+
+// expandCloudCredentialUsage returns the machine pools, etcd S3 configs and node templates referencing the cloud credential id
+func expandCloudCredentialUsage(id string, clusters []ClusterV2, nodeTemplates []managementClient.NodeTemplate) *cloudCredentialUsage {
+	obj := &cloudCredentialUsage{
+		MachinePools:     []cloudCredentialUsageMachinePool{},
+		NodeTemplateIDs:  []string{},
+		EtcdS3ClusterIDs: []string{},
+	}
+
+	for _, cluster := range clusters {
+		if cluster.Spec.RKEConfig == nil {
+			continue
+		}
+		for _, pool := range cluster.Spec.RKEConfig.MachinePools {
+			// Machine pools without cloud credential use the cluster one
+			ref := pool.CloudCredentialSecretName
+			if len(ref) == 0 {
+				ref = cluster.Spec.CloudCredentialSecretName
+			}
+			if isCloudCredentialRef(ref, id) {
+				obj.MachinePools = append(obj.MachinePools, cloudCredentialUsageMachinePool{
+					ClusterID: cluster.ID,
+					Name:      pool.Name,
+				})
+			}
+		}
+		etcd := cluster.Spec.RKEConfig.ETCD
+		if etcd != nil && etcd.S3 != nil && isCloudCredentialRef(etcd.S3.CloudCredentialName, id) {
+			obj.EtcdS3ClusterIDs = append(obj.EtcdS3ClusterIDs, cluster.ID)
+		}
+	}
+
+	for _, nodeTemplate := range nodeTemplates {
+		if isCloudCredentialRef(nodeTemplate.CloudCredentialID, id) {
+			obj.NodeTemplateIDs = append(obj.NodeTemplateIDs, nodeTemplate.ID)
+		}
+	}
+
+	sort.Slice(obj.MachinePools, func(i, j int) bool {
+		if obj.MachinePools[i].ClusterID != obj.MachinePools[j].ClusterID {
+			return obj.MachinePools[i].ClusterID < obj.MachinePools[j].ClusterID
+		}
+		return obj.MachinePools[i].Name < obj.MachinePools[j].Name
+	})
+	sort.Strings(obj.NodeTemplateIDs)
+	sort.Strings(obj.EtcdS3ClusterIDs)
+
+	return obj
+}
+
+// isCloudCredentialRef returns true if ref references the cloud credential id, with or without namespace
+func isCloudCredentialRef(ref, id string) bool {
+	if len(ref) == 0 || len(id) == 0 {
+		return false
+	}
+	if ref == id {
+		return true
+	}
+	_, name, found := strings.Cut(id, cloudCredentialIDSep)
+
+	return found && ref == name
+}
+
+func (u *cloudCredentialUsage) inUse() bool {
+	return u != nil && len(u.MachinePools)+len(u.NodeTemplateIDs)+len(u.EtcdS3ClusterIDs) > 0
+}
+
+func (u *cloudCredentialUsage) String() string {
+	if !u.inUse() {
+		return ""
+	}
+	refs := []string{}
+	for _, pool := range u.MachinePools {
+		refs = append(refs, fmt.Sprintf("cluster V2 %s machine pool %s", pool.ClusterID, pool.Name))
+	}
+	for _, id := range u.EtcdS3ClusterIDs {
+		refs = append(refs, fmt.Sprintf("cluster V2 %s etcd S3 config", id))
+	}
+	for _, id := range u.NodeTemplateIDs {
+		refs = append(refs, fmt.Sprintf("node template %s", id))
+	}
+
+	return strings.Join(refs, ", ")
+}
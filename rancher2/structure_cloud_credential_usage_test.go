@@ -0,0 +1,93 @@
+package rancher2
+
+import (
+	"testing"
+
+	provisioningV1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCloudCredentialUsageID = "cattle-global-data:cc-abcde"
+
+var (
+	testCloudCredentialUsageClusters      []ClusterV2
+	testCloudCredentialUsageNodeTemplates []managementClient.NodeTemplate
+)
+
+func init() {
+	etcdPool := provisioningV1.RKEMachinePool{Name: "etcd"}
+	etcdPool.CloudCredentialSecretName = "cattle-global-data:cc-other"
+	barPool := provisioningV1.RKEMachinePool{Name: "pool1"}
+	barPool.CloudCredentialSecretName = "cc-abcde"
+	foo := ClusterV2{}
+	foo.ID = "fleet-default/foo"
+	foo.Spec.CloudCredentialSecretName = testCloudCredentialUsageID
+	foo.Spec.RKEConfig = &provisioningV1.RKEConfig{
+		MachinePools: []provisioningV1.RKEMachinePool{
+			{Name: "worker"},
+			etcdPool,
+		},
+	}
+	bar := ClusterV2{}
+	bar.ID = "fleet-default/bar"
+	bar.Spec.RKEConfig = &provisioningV1.RKEConfig{
+		MachinePools: []provisioningV1.RKEMachinePool{barPool},
+	}
+	bar.Spec.RKEConfig.ETCD = &rkev1.ETCD{
+		S3: &rkev1.ETCDSnapshotS3{
+			CloudCredentialName: testCloudCredentialUsageID,
+		},
+	}
+	imported := ClusterV2{}
+	imported.ID = "fleet-default/imported"
+	testCloudCredentialUsageClusters = []ClusterV2{foo, bar, imported}
+
+	testCloudCredentialUsageNodeTemplates = []managementClient.NodeTemplate{
+		{
+			CloudCredentialID: testCloudCredentialUsageID,
+		},
+		{
+			CloudCredentialID: "cattle-global-data:cc-other",
+		},
+	}
+	testCloudCredentialUsageNodeTemplates[0].ID = "cattle-global-nt:nt-foo"
+	testCloudCredentialUsageNodeTemplates[1].ID = "cattle-global-nt:nt-bar"
+}
+
+func TestExpandCloudCredentialUsage(t *testing.T) {
+	expected := &cloudCredentialUsage{
+		MachinePools: []cloudCredentialUsageMachinePool{
+			{ClusterID: "fleet-default/bar", Name: "pool1"},
+			{ClusterID: "fleet-default/foo", Name: "worker"},
+		},
+		NodeTemplateIDs:  []string{"cattle-global-nt:nt-foo"},
+		EtcdS3ClusterIDs: []string{"fleet-default/bar"},
+	}
+
+	output := expandCloudCredentialUsage(testCloudCredentialUsageID, testCloudCredentialUsageClusters, testCloudCredentialUsageNodeTemplates)
+	assert.Equal(t, expected, output, "Unexpected output from expander.")
+	assert.True(t, output.inUse())
+
+	output = expandCloudCredentialUsage("cattle-global-data:cc-unused", testCloudCredentialUsageClusters, testCloudCredentialUsageNodeTemplates)
+	assert.False(t, output.inUse())
+	assert.Equal(t, "", output.String())
+}
+
+func TestIsCloudCredentialRef(t *testing.T) {
+
+	cases := []struct {
+		Ref            string
+		ExpectedOutput bool
+	}{
+		{testCloudCredentialUsageID, true},
+		{"cc-abcde", true},
+		{"cc-other", false},
+		{"", false},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.ExpectedOutput, isCloudCredentialRef(tc.Ref, testCloudCredentialUsageID), "Unexpected output for ref %q.", tc.Ref)
+	}
+}
@@ -17,10 +17,16 @@ var (
 	testCloudCredentialInterfaceDigitalocean map[string]interface{}
 	testCloudCredentialConfGoogle            *CloudCredential
 	testCloudCredentialInterfaceGoogle       map[string]interface{}
+	testCloudCredentialConfHetzner           *CloudCredential
+	testCloudCredentialInterfaceHetzner      map[string]interface{}
 	testCloudCredentialConfLinode            *CloudCredential
 	testCloudCredentialInterfaceLinode       map[string]interface{}
+	testCloudCredentialConfOpennebula        *CloudCredential
+	testCloudCredentialInterfaceOpennebula   map[string]interface{}
 	testCloudCredentialConfOpenstack         *CloudCredential
 	testCloudCredentialInterfaceOpenstack    map[string]interface{}
+	testCloudCredentialConfOutscale          *CloudCredential
+	testCloudCredentialInterfaceOutscale     map[string]interface{}
 	testCloudCredentialConfS3                *CloudCredential
 	testCloudCredentialInterfaceS3           map[string]interface{}
 	testCloudCredentialConfVsphere           *CloudCredential
@@ -72,6 +78,17 @@ func init() {
 		"google_credential_config": testCloudCredentialGoogleInterface,
 		"driver":                   googleConfigDriver,
 	}
+	testCloudCredentialConfHetzner = &CloudCredential{
+		HetznerCredentialConfig: testCloudCredentialHetznerConf,
+	}
+	testCloudCredentialConfHetzner.Name = "cloudCredential-test"
+	testCloudCredentialConfHetzner.Description = "description"
+	testCloudCredentialInterfaceHetzner = map[string]interface{}{
+		"name":                      "cloudCredential-test",
+		"description":               "description",
+		"hetzner_credential_config": testCloudCredentialHetznerInterface,
+		"driver":                    hetznerConfigDriver,
+	}
 	testCloudCredentialConfLinode = &CloudCredential{
 		LinodeCredentialConfig: testCloudCredentialLinodeConf,
 	}
@@ -83,6 +100,17 @@ func init() {
 		"linode_credential_config": testCloudCredentialLinodeInterface,
 		"driver":                   linodeConfigDriver,
 	}
+	testCloudCredentialConfOpennebula = &CloudCredential{
+		OpennebulaCredentialConfig: testCloudCredentialOpennebulaConf,
+	}
+	testCloudCredentialConfOpennebula.Name = "cloudCredential-test"
+	testCloudCredentialConfOpennebula.Description = "description"
+	testCloudCredentialInterfaceOpennebula = map[string]interface{}{
+		"name":                         "cloudCredential-test",
+		"description":                  "description",
+		"opennebula_credential_config": testCloudCredentialOpennebulaInterface,
+		"driver":                       opennebulaConfigDriver,
+	}
 	testCloudCredentialConfOpenstack = &CloudCredential{
 		OpenstackCredentialConfig: testCloudCredentialOpenstackConf,
 	}
@@ -95,6 +123,17 @@ func init() {
 		"driver":                      openstackConfigDriver,
 	}
 
+	testCloudCredentialConfOutscale = &CloudCredential{
+		OutscaleCredentialConfig: testCloudCredentialOutscaleConf,
+	}
+	testCloudCredentialConfOutscale.Name = "cloudCredential-test"
+	testCloudCredentialConfOutscale.Description = "description"
+	testCloudCredentialInterfaceOutscale = map[string]interface{}{
+		"name":                       "cloudCredential-test",
+		"description":                "description",
+		"outscale_credential_config": testCloudCredentialOutscaleInterface,
+		"driver":                     outscaleConfigDriver,
+	}
 	testCloudCredentialConfS3 = &CloudCredential{}
 	testCloudCredentialConfS3.S3CredentialConfig = testCloudCredentialS3Conf
 	testCloudCredentialConfS3.Name = "cloudCredential-test"
@@ -141,14 +180,26 @@ func TestFlattenCloudCredential(t *testing.T) {
 			testCloudCredentialConfGoogle,
 			testCloudCredentialInterfaceGoogle,
 		},
+		{
+			testCloudCredentialConfHetzner,
+			testCloudCredentialInterfaceHetzner,
+		},
 		{
 			testCloudCredentialConfLinode,
 			testCloudCredentialInterfaceLinode,
 		},
+		{
+			testCloudCredentialConfOpennebula,
+			testCloudCredentialInterfaceOpennebula,
+		},
 		{
 			testCloudCredentialConfOpenstack,
 			testCloudCredentialInterfaceOpenstack,
 		},
+		{
+			testCloudCredentialConfOutscale,
+			testCloudCredentialInterfaceOutscale,
+		},
 		{
 			testCloudCredentialConfS3,
 			testCloudCredentialInterfaceS3,
@@ -198,14 +249,26 @@ func TestExpandCloudCredential(t *testing.T) {
 			testCloudCredentialInterfaceGoogle,
 			testCloudCredentialConfGoogle,
 		},
+		{
+			testCloudCredentialInterfaceHetzner,
+			testCloudCredentialConfHetzner,
+		},
 		{
 			testCloudCredentialInterfaceLinode,
 			testCloudCredentialConfLinode,
 		},
+		{
+			testCloudCredentialInterfaceOpennebula,
+			testCloudCredentialConfOpennebula,
+		},
 		{
 			testCloudCredentialInterfaceOpenstack,
 			testCloudCredentialConfOpenstack,
 		},
+		{
+			testCloudCredentialInterfaceOutscale,
+			testCloudCredentialConfOutscale,
+		},
 		{
 			testCloudCredentialInterfaceS3,
 			testCloudCredentialConfS3,
@@ -147,7 +147,10 @@ func flattenCluster(d *schema.ResourceData, in *Cluster, clusterRegToken *manage
 		d.Set("ca_cert", in.CACert)
 	}
 
-	d.Set("kube_config", kubeConfig.Config)
+	err = setClusterKubeConfig(d, kubeConfig.Config)
+	if err != nil {
+		return err
+	}
 	d.Set("default_project_id", defaultProjectID)
 	d.Set("system_project_id", systemProjectID)
 	d.Set("driver", in.Driver)
@@ -171,7 +174,15 @@ func flattenCluster(d *schema.ResourceData, in *Cluster, clusterRegToken *manage
 		if !ok {
 			v = []interface{}{}
 		}
-		err = d.Set("aks_config_v2", flattenClusterAKSConfigV2(in.AKSConfig, v))
+		aksConfig := in.AKSConfig
+		if in.AKSStatus != nil && in.State == clusterStateActive {
+			aksConfig = mergeClusterAKSConfigV2Upstream(in.AKSConfig, in.AKSStatus.UpstreamSpec)
+		}
+		err = d.Set("aks_config_v2", flattenClusterAKSConfigV2(aksConfig, v))
+		if err != nil {
+			return err
+		}
+		err = d.Set("aks_config_v2_upstream", flattenClusterAKSConfigV2Upstream(in.AKSStatus))
 		if err != nil {
 			return err
 		}
@@ -193,7 +204,15 @@ func flattenCluster(d *schema.ResourceData, in *Cluster, clusterRegToken *manage
 		if !ok {
 			v = []interface{}{}
 		}
-		err = d.Set("eks_config_v2", flattenClusterEKSConfigV2(in.EKSConfig, v))
+		eksConfig := in.EKSConfig
+		if in.EKSStatus != nil && in.State == clusterStateActive {
+			eksConfig = mergeClusterEKSConfigV2Upstream(in.EKSConfig, in.EKSStatus.UpstreamSpec)
+		}
+		err = d.Set("eks_config_v2", flattenClusterEKSConfigV2(eksConfig, v))
+		if err != nil {
+			return err
+		}
+		err = d.Set("eks_config_v2_upstream", flattenClusterEKSConfigV2Upstream(in.EKSStatus))
 		if err != nil {
 			return err
 		}
@@ -215,8 +234,15 @@ func flattenCluster(d *schema.ResourceData, in *Cluster, clusterRegToken *manage
 		if !ok {
 			v = []interface{}{}
 		}
-		gkeConfig := flattenClusterGKEConfigV2(in.GKEConfig, v)
-		err = d.Set("gke_config_v2", gkeConfig)
+		gkeConfig := in.GKEConfig
+		if in.GKEStatus != nil && in.State == clusterStateActive {
+			gkeConfig = mergeClusterGKEConfigV2Upstream(in.GKEConfig, in.GKEStatus.UpstreamSpec)
+		}
+		err = d.Set("gke_config_v2", flattenClusterGKEConfigV2(gkeConfig, v))
+		if err != nil {
+			return err
+		}
+		err = d.Set("gke_config_v2_upstream", flattenClusterGKEConfigV2Upstream(in.GKEStatus))
 		if err != nil {
 			return err
 		}
@@ -234,6 +260,14 @@ func flattenCluster(d *schema.ResourceData, in *Cluster, clusterRegToken *manage
 		if err != nil {
 			return err
 		}
+	default:
+		if in.GenericConfig != nil {
+			v, _ := d.Get("generic_config").([]interface{})
+			err = d.Set("generic_config", flattenDriverConfig(in.GenericConfigDriver, in.GenericConfig, v))
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// Setting k3s_config, rke2_config and rke_config always as computed
@@ -541,6 +575,13 @@ func expandCluster(in *schema.ResourceData) (*Cluster, error) {
 		obj.Driver = clusterOKEKind
 	}
 
+	if v, ok := in.Get("generic_config").([]interface{}); ok && len(v) > 0 {
+		driver, fields, sensitiveFields := expandDriverConfig(v)
+		obj.GenericConfig = mergeDriverConfigFields(fields, sensitiveFields)
+		obj.GenericConfigDriver = driver
+		obj.Driver = driver
+	}
+
 	if v, ok := in.Get("k3s_config").([]interface{}); ok && len(v) > 0 {
 		obj.K3sConfig = expandClusterK3SConfig(v)
 		obj.Driver = clusterDriverK3S
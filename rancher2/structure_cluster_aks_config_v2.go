@@ -188,6 +188,96 @@ func flattenClusterAKSConfigV2(in *managementClient.AKSClusterConfigSpec, p []in
 	return []interface{}{obj}
 }
 
+// flattenClusterAKSConfigV2Upstream flattens the AKS cluster upstream spec, as seen by Rancher
+func flattenClusterAKSConfigV2Upstream(in *managementClient.AKSStatus) []interface{} {
+	if in == nil || in.UpstreamSpec == nil {
+		return []interface{}{}
+	}
+	upstream := *in.UpstreamSpec
+	upstream.Imported = false
+
+	return flattenClusterAKSConfigV2(&upstream, []interface{}{})
+}
+
+// mergeClusterAKSConfigV2Upstream returns config with its managed, not nil, updatable fields set from upstream, to detect
+// drift. Node pools are matched by name and the ones missing upstream are removed. Unmanaged ones are just reported
+// at the upstream spec, not added
+func mergeClusterAKSConfigV2Upstream(config, upstream *managementClient.AKSClusterConfigSpec) *managementClient.AKSClusterConfigSpec {
+	if config == nil || upstream == nil || config.Imported {
+		return config
+	}
+	out := *config
+
+	if config.AuthorizedIPRanges != nil && upstream.AuthorizedIPRanges != nil {
+		out.AuthorizedIPRanges = upstream.AuthorizedIPRanges
+	}
+	if config.HTTPApplicationRouting != nil && upstream.HTTPApplicationRouting != nil {
+		out.HTTPApplicationRouting = upstream.HTTPApplicationRouting
+	}
+	if config.KubernetesVersion != nil && upstream.KubernetesVersion != nil {
+		out.KubernetesVersion = upstream.KubernetesVersion
+	}
+	if config.Monitoring != nil && upstream.Monitoring != nil {
+		out.Monitoring = upstream.Monitoring
+	}
+	if config.Tags != nil && upstream.Tags != nil {
+		out.Tags = upstream.Tags
+	}
+	if config.NodePools != nil && upstream.NodePools != nil {
+		out.NodePools = mergeClusterAKSConfigV2UpstreamNodePools(config.NodePools, upstream.NodePools)
+	}
+
+	return &out
+}
+
+func mergeClusterAKSConfigV2UpstreamNodePools(config, upstream []managementClient.AKSNodePool) []managementClient.AKSNodePool {
+	upstreamNodePools := make(map[string]managementClient.AKSNodePool, len(upstream))
+	for _, nodePool := range upstream {
+		if nodePool.Name != nil {
+			upstreamNodePools[*nodePool.Name] = nodePool
+		}
+	}
+
+	out := make([]managementClient.AKSNodePool, 0, len(config))
+	for _, nodePool := range config {
+		if nodePool.Name == nil {
+			out = append(out, nodePool)
+			continue
+		}
+		upstreamNodePool, ok := upstreamNodePools[*nodePool.Name]
+		if !ok {
+			continue
+		}
+		if nodePool.Count != nil && upstreamNodePool.Count != nil {
+			nodePool.Count = upstreamNodePool.Count
+		}
+		if nodePool.EnableAutoScaling != nil && upstreamNodePool.EnableAutoScaling != nil {
+			nodePool.EnableAutoScaling = upstreamNodePool.EnableAutoScaling
+		}
+		if nodePool.MaxCount != nil && upstreamNodePool.MaxCount != nil {
+			nodePool.MaxCount = upstreamNodePool.MaxCount
+		}
+		if nodePool.MinCount != nil && upstreamNodePool.MinCount != nil {
+			nodePool.MinCount = upstreamNodePool.MinCount
+		}
+		if len(nodePool.Mode) > 0 && len(upstreamNodePool.Mode) > 0 {
+			nodePool.Mode = upstreamNodePool.Mode
+		}
+		if nodePool.NodeLabels != nil && upstreamNodePool.NodeLabels != nil {
+			nodePool.NodeLabels = upstreamNodePool.NodeLabels
+		}
+		if nodePool.NodeTaints != nil && upstreamNodePool.NodeTaints != nil {
+			nodePool.NodeTaints = upstreamNodePool.NodeTaints
+		}
+		if nodePool.OrchestratorVersion != nil && upstreamNodePool.OrchestratorVersion != nil {
+			nodePool.OrchestratorVersion = upstreamNodePool.OrchestratorVersion
+		}
+		out = append(out, nodePool)
+	}
+
+	return out
+}
+
 // Expanders
 
 func expandClusterAKSConfigV2NodePools(p []interface{}) []managementClient.AKSNodePool {
@@ -209,3 +209,38 @@ func TestExpandClusterAKSConfigV2(t *testing.T) {
 		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
 	}
 }
+
+func TestMergeClusterAKSConfigV2Upstream(t *testing.T) {
+	one := int64(1)
+	three := int64(3)
+	config := &managementClient.AKSClusterConfigSpec{
+		ClusterName:       "test",
+		KubernetesVersion: newString("1.28.3"),
+		NodePools: []managementClient.AKSNodePool{
+			{Name: newString("pool1"), Count: &one, Mode: "System", VMSize: "Standard_DS2_v2"},
+			{Name: newString("pool2"), Count: &one, Mode: "User"},
+		},
+	}
+	upstream := &managementClient.AKSClusterConfigSpec{
+		ClusterName:       "test",
+		KubernetesVersion: newString("1.28.5"),
+		Monitoring:        newTrue(),
+		NodePools: []managementClient.AKSNodePool{
+			{Name: newString("pool1"), Count: &three, Mode: "System", VMSize: "Standard_DS3_v2"},
+			{Name: newString("pool3"), Count: &one, Mode: "User"},
+		},
+	}
+	expected := &managementClient.AKSClusterConfigSpec{
+		ClusterName:       "test",
+		KubernetesVersion: newString("1.28.5"),
+		NodePools: []managementClient.AKSNodePool{
+			{Name: newString("pool1"), Count: &three, Mode: "System", VMSize: "Standard_DS2_v2"},
+		},
+	}
+
+	output := mergeClusterAKSConfigV2Upstream(config, upstream)
+	assert.Equal(t, expected, output, "Unexpected output from merge.")
+
+	config.Imported = true
+	assert.Equal(t, config, mergeClusterAKSConfigV2Upstream(config, upstream), "Imported config should not be merged.")
+}
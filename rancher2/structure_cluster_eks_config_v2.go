@@ -172,6 +172,90 @@ func flattenClusterEKSConfigV2(in *managementClient.EKSClusterConfigSpec, p []in
 	return []interface{}{obj}
 }
 
+// flattenClusterEKSConfigV2Upstream flattens the EKS cluster upstream spec, as seen by Rancher
+func flattenClusterEKSConfigV2Upstream(in *managementClient.EKSStatus) []interface{} {
+	if in == nil || in.UpstreamSpec == nil {
+		return []interface{}{}
+	}
+	upstream := *in.UpstreamSpec
+	upstream.Imported = false
+
+	return flattenClusterEKSConfigV2(&upstream, []interface{}{})
+}
+
+// mergeClusterEKSConfigV2Upstream returns config with its managed, not nil, updatable fields set from upstream, to detect
+// drift. Node groups are matched by name and the ones missing upstream are removed. Unmanaged ones are just reported
+// at the upstream spec, not added
+func mergeClusterEKSConfigV2Upstream(config, upstream *managementClient.EKSClusterConfigSpec) *managementClient.EKSClusterConfigSpec {
+	if config == nil || upstream == nil || config.Imported {
+		return config
+	}
+	out := *config
+
+	if config.KubernetesVersion != nil && upstream.KubernetesVersion != nil {
+		out.KubernetesVersion = upstream.KubernetesVersion
+	}
+	if config.LoggingTypes != nil && upstream.LoggingTypes != nil {
+		out.LoggingTypes = upstream.LoggingTypes
+	}
+	if config.PrivateAccess != nil && upstream.PrivateAccess != nil {
+		out.PrivateAccess = upstream.PrivateAccess
+	}
+	if config.PublicAccess != nil && upstream.PublicAccess != nil {
+		out.PublicAccess = upstream.PublicAccess
+	}
+	if config.PublicAccessSources != nil && upstream.PublicAccessSources != nil {
+		out.PublicAccessSources = upstream.PublicAccessSources
+	}
+	if config.Tags != nil && upstream.Tags != nil {
+		out.Tags = upstream.Tags
+	}
+	if config.NodeGroups != nil && upstream.NodeGroups != nil {
+		out.NodeGroups = mergeClusterEKSConfigV2UpstreamNodeGroups(config.NodeGroups, upstream.NodeGroups)
+	}
+
+	return &out
+}
+
+func mergeClusterEKSConfigV2UpstreamNodeGroups(config, upstream []managementClient.NodeGroup) []managementClient.NodeGroup {
+	upstreamNodeGroups := make(map[string]managementClient.NodeGroup, len(upstream))
+	for _, nodeGroup := range upstream {
+		if nodeGroup.NodegroupName != nil {
+			upstreamNodeGroups[*nodeGroup.NodegroupName] = nodeGroup
+		}
+	}
+
+	out := make([]managementClient.NodeGroup, 0, len(config))
+	for _, nodeGroup := range config {
+		if nodeGroup.NodegroupName == nil {
+			out = append(out, nodeGroup)
+			continue
+		}
+		upstreamNodeGroup, ok := upstreamNodeGroups[*nodeGroup.NodegroupName]
+		if !ok {
+			continue
+		}
+		if nodeGroup.DesiredSize != nil && upstreamNodeGroup.DesiredSize != nil {
+			nodeGroup.DesiredSize = upstreamNodeGroup.DesiredSize
+		}
+		if nodeGroup.MaxSize != nil && upstreamNodeGroup.MaxSize != nil {
+			nodeGroup.MaxSize = upstreamNodeGroup.MaxSize
+		}
+		if nodeGroup.MinSize != nil && upstreamNodeGroup.MinSize != nil {
+			nodeGroup.MinSize = upstreamNodeGroup.MinSize
+		}
+		if nodeGroup.Labels != nil && upstreamNodeGroup.Labels != nil {
+			nodeGroup.Labels = upstreamNodeGroup.Labels
+		}
+		if nodeGroup.Version != nil && upstreamNodeGroup.Version != nil {
+			nodeGroup.Version = upstreamNodeGroup.Version
+		}
+		out = append(out, nodeGroup)
+	}
+
+	return out
+}
+
 // Expanders
 
 func expandClusterEKSConfigV2NodeGroupsLaunchTemplate(p []interface{}) *managementClient.LaunchTemplate {
@@ -298,7 +382,8 @@ func expandClusterEKSConfigV2(p []interface{}) *managementClient.EKSClusterConfi
 		subnets = toArrayStringSorted(v)
 		obj.Subnets = subnets
 	}
-	if v, ok := in["node_groups"].([]interface{}); ok {
+	readOnlyNodeGroups, _ := in["read_only_node_groups"].(bool)
+	if v, ok := in["node_groups"].([]interface{}); ok && !readOnlyNodeGroups {
 		nodeGroups := expandClusterEKSConfigV2NodeGroups(v, subnets, k8sVersion)
 		obj.NodeGroups = nodeGroups
 	}
@@ -212,3 +212,66 @@ func TestExpandClusterEKSConfigV2(t *testing.T) {
 		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
 	}
 }
+
+func TestMergeClusterEKSConfigV2Upstream(t *testing.T) {
+	one := int64(1)
+	two := int64(2)
+	five := int64(5)
+	config := &managementClient.EKSClusterConfigSpec{
+		DisplayName:       "test",
+		KubernetesVersion: newString("1.28"),
+		Tags:              map[string]string{"foo": "bar"},
+		NodeGroups: []managementClient.NodeGroup{
+			{NodegroupName: newString("ng1"), DesiredSize: &two, InstanceType: newString("t3.medium")},
+			{NodegroupName: newString("ng2"), DesiredSize: &one},
+		},
+	}
+	upstream := &managementClient.EKSClusterConfigSpec{
+		DisplayName:       "test",
+		KubernetesVersion: newString("1.28"),
+		PrivateAccess:     newTrue(),
+		Tags:              map[string]string{"foo": "changed"},
+		NodeGroups: []managementClient.NodeGroup{
+			{NodegroupName: newString("ng1"), DesiredSize: &five, InstanceType: newString("t3.large")},
+			{NodegroupName: newString("ng3"), DesiredSize: &one},
+		},
+	}
+	expected := &managementClient.EKSClusterConfigSpec{
+		DisplayName:       "test",
+		KubernetesVersion: newString("1.28"),
+		Tags:              map[string]string{"foo": "changed"},
+		NodeGroups: []managementClient.NodeGroup{
+			{NodegroupName: newString("ng1"), DesiredSize: &five, InstanceType: newString("t3.medium")},
+		},
+	}
+
+	output := mergeClusterEKSConfigV2Upstream(config, upstream)
+	assert.Equal(t, expected, output, "Unexpected output from merge.")
+	assert.Equal(t, int64(2), *config.NodeGroups[0].DesiredSize, "Config should not be modified.")
+
+	config.Imported = true
+	assert.Equal(t, config, mergeClusterEKSConfigV2Upstream(config, upstream), "Imported config should not be merged.")
+}
+
+func TestExpandClusterEKSConfigV2Imported(t *testing.T) {
+	newInput := func(readOnlyNodeGroups bool) []interface{} {
+		in := map[string]interface{}{}
+		for k, v := range testClusterEKSConfigV2Interface[0].(map[string]interface{}) {
+			in[k] = v
+		}
+		in["imported"] = true
+		in["read_only_node_groups"] = readOnlyNodeGroups
+		return []interface{}{in}
+	}
+
+	// Imported cluster node groups are managed if configured
+	output := expandClusterEKSConfigV2(newInput(false))
+	assert.True(t, output.Imported, "Unexpected imported from expander.")
+	assert.Equal(t, testClusterEKSConfigV2NodeGroupConf, output.NodeGroups, "Unexpected node groups from expander.")
+	assert.Nil(t, output.PrivateAccess, "Unexpected private access from expander.")
+
+	// Imported cluster node groups are not managed if read only
+	output = expandClusterEKSConfigV2(newInput(true))
+	assert.True(t, output.Imported, "Unexpected imported from expander.")
+	assert.Nil(t, output.NodeGroups, "Unexpected node groups from expander.")
+}
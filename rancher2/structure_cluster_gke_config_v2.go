@@ -319,6 +319,96 @@ func flattenClusterGKEConfigV2(in *managementClient.GKEClusterConfigSpec, p []in
 	return []interface{}{obj}
 }
 
+// flattenClusterGKEConfigV2Upstream flattens the GKE cluster upstream spec, as seen by Rancher
+func flattenClusterGKEConfigV2Upstream(in *managementClient.GKEStatus) []interface{} {
+	if in == nil || in.UpstreamSpec == nil {
+		return []interface{}{}
+	}
+	upstream := *in.UpstreamSpec
+	upstream.Imported = false
+
+	return flattenClusterGKEConfigV2(&upstream, []interface{}{})
+}
+
+// mergeClusterGKEConfigV2Upstream returns config with its managed, not nil, updatable fields set from upstream, to detect
+// drift. Node pools are matched by name and the ones missing upstream are removed. Unmanaged ones are just reported
+// at the upstream spec, not added
+func mergeClusterGKEConfigV2Upstream(config, upstream *managementClient.GKEClusterConfigSpec) *managementClient.GKEClusterConfigSpec {
+	if config == nil || upstream == nil || config.Imported {
+		return config
+	}
+	out := *config
+
+	if config.ClusterAddons != nil && upstream.ClusterAddons != nil {
+		out.ClusterAddons = upstream.ClusterAddons
+	}
+	if config.KubernetesVersion != nil && upstream.KubernetesVersion != nil {
+		out.KubernetesVersion = upstream.KubernetesVersion
+	}
+	if config.Labels != nil && upstream.Labels != nil {
+		out.Labels = upstream.Labels
+	}
+	if config.Locations != nil && upstream.Locations != nil {
+		out.Locations = upstream.Locations
+	}
+	if config.LoggingService != nil && upstream.LoggingService != nil {
+		out.LoggingService = upstream.LoggingService
+	}
+	if config.MaintenanceWindow != nil && upstream.MaintenanceWindow != nil {
+		out.MaintenanceWindow = upstream.MaintenanceWindow
+	}
+	if config.MasterAuthorizedNetworksConfig != nil && upstream.MasterAuthorizedNetworksConfig != nil {
+		out.MasterAuthorizedNetworksConfig = upstream.MasterAuthorizedNetworksConfig
+	}
+	if config.MonitoringService != nil && upstream.MonitoringService != nil {
+		out.MonitoringService = upstream.MonitoringService
+	}
+	if config.NetworkPolicyEnabled != nil && upstream.NetworkPolicyEnabled != nil {
+		out.NetworkPolicyEnabled = upstream.NetworkPolicyEnabled
+	}
+	if config.NodePools != nil && upstream.NodePools != nil {
+		out.NodePools = mergeClusterGKEConfigV2UpstreamNodePools(config.NodePools, upstream.NodePools)
+	}
+
+	return &out
+}
+
+func mergeClusterGKEConfigV2UpstreamNodePools(config, upstream []managementClient.GKENodePoolConfig) []managementClient.GKENodePoolConfig {
+	upstreamNodePools := make(map[string]managementClient.GKENodePoolConfig, len(upstream))
+	for _, nodePool := range upstream {
+		if nodePool.Name != nil {
+			upstreamNodePools[*nodePool.Name] = nodePool
+		}
+	}
+
+	out := make([]managementClient.GKENodePoolConfig, 0, len(config))
+	for _, nodePool := range config {
+		if nodePool.Name == nil {
+			out = append(out, nodePool)
+			continue
+		}
+		upstreamNodePool, ok := upstreamNodePools[*nodePool.Name]
+		if !ok {
+			continue
+		}
+		if nodePool.Autoscaling != nil && upstreamNodePool.Autoscaling != nil {
+			nodePool.Autoscaling = upstreamNodePool.Autoscaling
+		}
+		if nodePool.InitialNodeCount != nil && upstreamNodePool.InitialNodeCount != nil {
+			nodePool.InitialNodeCount = upstreamNodePool.InitialNodeCount
+		}
+		if nodePool.Management != nil && upstreamNodePool.Management != nil {
+			nodePool.Management = upstreamNodePool.Management
+		}
+		if nodePool.Version != nil && upstreamNodePool.Version != nil {
+			nodePool.Version = upstreamNodePool.Version
+		}
+		out = append(out, nodePool)
+	}
+
+	return out
+}
+
 // Expanders
 
 func expandClusterGKEConfigV2ClusterAddons(p []interface{}) *managementClient.GKEClusterAddons {
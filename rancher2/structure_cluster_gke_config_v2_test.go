@@ -470,3 +470,37 @@ func TestExpandClusterGKEConfigV2(t *testing.T) {
 		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
 	}
 }
+
+func TestMergeClusterGKEConfigV2Upstream(t *testing.T) {
+	one := int64(1)
+	four := int64(4)
+	config := &managementClient.GKEClusterConfigSpec{
+		ClusterName: "test",
+		Labels:      map[string]string{"foo": "bar"},
+		NodePools: []managementClient.GKENodePoolConfig{
+			{Name: newString("pool1"), InitialNodeCount: &one, Version: newString("1.28")},
+		},
+	}
+	upstream := &managementClient.GKEClusterConfigSpec{
+		ClusterName:    "test",
+		Labels:         map[string]string{},
+		LoggingService: newString("logging.googleapis.com/kubernetes"),
+		NodePools: []managementClient.GKENodePoolConfig{
+			{Name: newString("pool1"), InitialNodeCount: &four, Version: newString("1.28")},
+			{Name: newString("pool2"), InitialNodeCount: &one},
+		},
+	}
+	expected := &managementClient.GKEClusterConfigSpec{
+		ClusterName: "test",
+		Labels:      map[string]string{},
+		NodePools: []managementClient.GKENodePoolConfig{
+			{Name: newString("pool1"), InitialNodeCount: &four, Version: newString("1.28")},
+		},
+	}
+
+	output := mergeClusterGKEConfigV2Upstream(config, upstream)
+	assert.Equal(t, expected, output, "Unexpected output from merge.")
+
+	config.Imported = true
+	assert.Equal(t, config, mergeClusterGKEConfigV2Upstream(config, upstream), "Imported config should not be merged.")
+}
@@ -0,0 +1,46 @@
+package rancher2
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// splitClusterImportManifest splits the cluster import manifest into its YAML documents, skipping
+// the empty ones
+func splitClusterImportManifest(manifest string) ([]interface{}, error) {
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(strings.NewReader(manifest)))
+	docs := []interface{}{}
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Splitting cluster import manifest: %v", err)
+		}
+		if isEmptyYAMLDocument(string(doc)) {
+			continue
+		}
+		// First document may start with the separator
+		trimmed := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(string(doc)), "---\n"))
+		docs = append(docs, trimmed+"\n")
+	}
+
+	return docs, nil
+}
+
+// isEmptyYAMLDocument returns true if doc has just comments, blank lines or separators
+func isEmptyYAMLDocument(doc string) bool {
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || line == "---" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testClusterImportManifest = `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: cattle-system
+
+---
+# just a comment
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: cattle-credentials
+  namespace: cattle-system
+data:
+  token: "dG9rZW4="
+---
+`
+)
+
+func TestSplitClusterImportManifest(t *testing.T) {
+
+	cases := []struct {
+		Input          string
+		ExpectedOutput []interface{}
+	}{
+		{
+			testClusterImportManifest,
+			[]interface{}{
+				"apiVersion: v1\nkind: Namespace\nmetadata:\n  name: cattle-system\n",
+				"apiVersion: v1\nkind: Secret\nmetadata:\n  name: cattle-credentials\n  namespace: cattle-system\ndata:\n  token: \"dG9rZW4=\"\n",
+			},
+		},
+		{
+			"",
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := splitClusterImportManifest(tc.Input)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from splitter.")
+	}
+}
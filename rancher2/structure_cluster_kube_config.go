@@ -0,0 +1,107 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	kubeconfig "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+// Flatteners
+
+func flattenClusterKubeConfigContexts(in *kubeconfig.Config) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	clusters := map[string]kubeconfig.Cluster{}
+	for _, cluster := range in.Clusters {
+		clusters[cluster.Name] = cluster.Cluster
+	}
+	users := map[string]kubeconfig.AuthInfo{}
+	for _, user := range in.AuthInfos {
+		users[user.Name] = user.AuthInfo
+	}
+
+	out := make([]interface{}, 0, len(in.Contexts))
+	for _, context := range in.Contexts {
+		cluster := clusters[context.Context.Cluster]
+		obj := map[string]interface{}{
+			"name":                  context.Name,
+			"server":                cluster.Server,
+			"certificate_authority": string(cluster.CertificateAuthorityData),
+			"token":                 users[context.Context.AuthInfo].Token,
+			"current":               context.Name == in.CurrentContext,
+		}
+		switch {
+		case strings.Contains(cluster.Server, clusterKubeConfigProxyPath):
+			obj["endpoint_type"] = clusterKubeConfigEndpointRancherProxy
+		case strings.HasSuffix(context.Name, clusterKubeConfigFQDNContextSuffix):
+			obj["endpoint_type"] = clusterKubeConfigEndpointACEFQDN
+		default:
+			obj["endpoint_type"] = clusterKubeConfigEndpointACENode
+		}
+		out = append(out, obj)
+	}
+
+	return out
+}
+
+// setClusterKubeConfigCurrentContext returns config with context as current context. config is returned
+// as it is if context is empty or already the current one
+func setClusterKubeConfigCurrentContext(config, context string) (string, error) {
+	if len(config) == 0 || len(context) == 0 {
+		return config, nil
+	}
+	obj, err := getObjFromKubeConfig(config)
+	if err != nil {
+		return "", err
+	}
+	if obj.CurrentContext == context {
+		return config, nil
+	}
+	for _, c := range obj.Contexts {
+		if c.Name == context {
+			obj.CurrentContext = context
+			return getKubeConfigFromObj(obj)
+		}
+	}
+	return "", fmt.Errorf("kube_config_context %q not found on kube_config", context)
+}
+
+// setClusterKubeConfig sets kube_config, with kube_config_context as current context, and its structured data
+func setClusterKubeConfig(d *schema.ResourceData, config string) error {
+	context, _ := d.Get("kube_config_context").(string)
+	config, err := setClusterKubeConfigCurrentContext(config, context)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Setting kube_config: %v", err)
+	}
+	// Structured data is informative, not failing if kube_config can't be parsed
+	obj, err := getObjFromKubeConfig(config)
+	if err != nil {
+		log.Printf("[WARN] Setting kube_config structured data: %v", err)
+	}
+
+	contexts := flattenClusterKubeConfigContexts(obj)
+	host, caCert, token := "", "", ""
+	for _, c := range contexts {
+		if current := c.(map[string]interface{}); current["current"].(bool) {
+			host = current["server"].(string)
+			caCert = current["certificate_authority"].(string)
+			token = current["token"].(string)
+		}
+	}
+
+	d.Set("kube_config", config)
+	err = d.Set("kube_config_contexts", contexts)
+	if err != nil {
+		return err
+	}
+	d.Set("kube_config_host", host)
+	d.Set("kube_config_cluster_ca_certificate", caCert)
+	d.Set("kube_config_token", token)
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testClusterKubeConfigCA = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	testClusterKubeConfig   = `apiVersion: v1
+kind: Config
+clusters:
+- name: "foo"
+  cluster:
+    server: "https://rancher.example.com/k8s/clusters/c-m-abcdef"
+    certificate-authority-data: "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUIKLS0tLS1FTkQgQ0VSVElGSUNBVEUtLS0tLQo="
+- name: "foo-node-1"
+  cluster:
+    server: "https://10.0.0.1:6443"
+- name: "foo-fqdn"
+  cluster:
+    server: "https://foo.example.com"
+users:
+- name: "foo"
+  user:
+    token: "kubeconfig-user-abc:secret"
+contexts:
+- name: "foo"
+  context:
+    user: "foo"
+    cluster: "foo"
+- name: "foo-node-1"
+  context:
+    user: "foo"
+    cluster: "foo-node-1"
+- name: "foo-fqdn"
+  context:
+    user: "foo"
+    cluster: "foo-fqdn"
+current-context: "foo"
+`
+)
+
+func TestFlattenClusterKubeConfigContexts(t *testing.T) {
+	obj, err := getObjFromKubeConfig(testClusterKubeConfig)
+	assert.NoError(t, err)
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":                  "foo",
+			"server":                "https://rancher.example.com/k8s/clusters/c-m-abcdef",
+			"certificate_authority": testClusterKubeConfigCA,
+			"token":                 "kubeconfig-user-abc:secret",
+			"endpoint_type":         clusterKubeConfigEndpointRancherProxy,
+			"current":               true,
+		},
+		map[string]interface{}{
+			"name":                  "foo-node-1",
+			"server":                "https://10.0.0.1:6443",
+			"certificate_authority": "",
+			"token":                 "kubeconfig-user-abc:secret",
+			"endpoint_type":         clusterKubeConfigEndpointACENode,
+			"current":               false,
+		},
+		map[string]interface{}{
+			"name":                  "foo-fqdn",
+			"server":                "https://foo.example.com",
+			"certificate_authority": "",
+			"token":                 "kubeconfig-user-abc:secret",
+			"endpoint_type":         clusterKubeConfigEndpointACEFQDN,
+			"current":               false,
+		},
+	}
+
+	assert.Equal(t, expected, flattenClusterKubeConfigContexts(obj), "Unexpected output from flattener.")
+}
+
+func TestSetClusterKubeConfigCurrentContext(t *testing.T) {
+
+	cases := []struct {
+		Context         string
+		ExpectedCurrent string
+		ExpectedErr     bool
+	}{
+		{"", "foo", false},
+		{"foo", "foo", false},
+		{"foo-fqdn", "foo-fqdn", false},
+		{"bar", "", true},
+	}
+
+	for _, tc := range cases {
+		output, err := setClusterKubeConfigCurrentContext(testClusterKubeConfig, tc.Context)
+		if tc.ExpectedErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		obj, err := getObjFromKubeConfig(output)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedCurrent, obj.CurrentContext, "Unexpected current context.")
+		assert.Equal(t, 3, len(obj.Contexts), "Unexpected contexts.")
+	}
+}
@@ -1,6 +1,7 @@
 package rancher2
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -724,6 +725,62 @@ func TestExpandCluster(t *testing.T) {
 	}
 }
 
+func TestFlattenExpandClusterGenericConfig(t *testing.T) {
+	testCluster()
+	// Another kontainer driver config is also returned, the driver one should be picked every time
+	in := `{"id":"id","name":"test","driver":"lke","otherEngineConfig":{"driverName":"other","region":"eu-west"},"lkeEngineConfig":{"driverName":"lke","region":"us-east","nodePoolCount":3,"accessToken":null}}`
+	configured := map[string]interface{}{
+		"name": "test",
+		"generic_config": []interface{}{
+			map[string]interface{}{
+				"driver": "lke",
+				"fields": map[string]interface{}{
+					"region":        "us-east",
+					"nodePoolCount": "3",
+				},
+				"sensitive_fields": map[string]interface{}{
+					"accessToken": "token",
+				},
+			},
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"driver": "lke",
+			"fields": map[string]interface{}{
+				"region":        "us-east",
+				"nodePoolCount": "3",
+			},
+			"sensitive_fields": map[string]interface{}{
+				"accessToken": "token",
+			},
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		cluster := &Cluster{}
+		err := json.Unmarshal([]byte(in), cluster)
+		if err != nil {
+			assert.FailNow(t, "[ERROR] unmarshalling cluster: %#v", err)
+		}
+		d := schema.TestResourceDataRaw(t, clusterFields(), configured)
+		testClusterRegistrationTokenConf.ID = "id"
+		err = flattenCluster(d, cluster, testClusterRegistrationTokenConf, testClusterGenerateKubeConfigOutput, "default_project_id", "system_project_id")
+		if err != nil {
+			assert.FailNow(t, "[ERROR] on flattener: %#v", err)
+		}
+		assert.Equal(t, expected, d.Get("generic_config"), "Unexpected output from flattener.")
+
+		output, err := expandCluster(d)
+		if err != nil {
+			assert.FailNow(t, "[ERROR] on expander: %#v", err)
+		}
+		assert.Equal(t, "lke", output.Driver, "Unexpected output from expander.")
+		assert.Equal(t, "lke", output.GenericConfigDriver, "Unexpected output from expander.")
+		assert.Equal(t, map[string]interface{}{"region": "us-east", "nodePoolCount": "3", "accessToken": "token"}, output.GenericConfig, "Unexpected output from expander.")
+	}
+}
+
 func TestFlattenClusterWithPreservedClusterTemplateAnswers(t *testing.T) {
 	testCluster()
 	testClusterInterfaceTemplate["cluster_template_answers"] = []interface{}{
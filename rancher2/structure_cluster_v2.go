@@ -17,6 +17,12 @@ const (
 	clusterV2CreatedCondition = "Created"
 )
 
+var (
+	// rke_config fields triggering one time actions, that may be managed by rancher2_cluster_v2_etcd_restore
+	// and rancher2_cluster_v2_certificate_rotation resources
+	clusterV2RKEConfigActionFields = []string{"etcd_snapshot_restore", "rotate_certificates"}
+)
+
 //Types
 
 type ClusterV2 struct {
@@ -51,7 +57,17 @@ func flattenClusterV2(d *schema.ResourceData, in *ClusterV2) error {
 	}
 	d.Set("local_auth_endpoint", flattenClusterV2LocalAuthEndpoint(in.Spec.LocalClusterAuthEndpoint))
 	if in.Spec.RKEConfig != nil {
-		d.Set("rke_config", flattenClusterV2RKEConfig(in.Spec.RKEConfig))
+		current, _ := d.Get("rke_config").([]interface{})
+		rkeConfig := flattenClusterV2RKEConfigWithMachineSettings(in.Spec.RKEConfig, current)
+		// Action fields may be managed by their own resources, ignoring them if not set at rke_config
+		if len(rkeConfig) > 0 && len(current) > 0 && current[0] != nil {
+			for _, field := range clusterV2RKEConfigActionFields {
+				if v, ok := current[0].(map[string]interface{})[field].([]interface{}); !ok || len(v) == 0 {
+					delete(rkeConfig[0].(map[string]interface{}), field)
+				}
+			}
+		}
+		d.Set("rke_config", rkeConfig)
 	}
 	if in.Spec.AgentEnvVars != nil && len(in.Spec.AgentEnvVars) > 0 {
 		d.Set("agent_env_vars", flattenEnvVarsV2(in.Spec.AgentEnvVars))
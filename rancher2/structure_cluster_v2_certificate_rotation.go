@@ -0,0 +1,91 @@
+package rancher2
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+// Flatteners
+
+// flattenClusterV2CertificatesExpiration returns the certificates sorted by name. If within is greater than 0, only the
+// certificates expiring before now + within are returned
+func flattenClusterV2CertificatesExpiration(in []clusterV2CertificateExpiration, within time.Duration, now time.Time) []interface{} {
+	certificates := append([]clusterV2CertificateExpiration{}, in...)
+	sort.SliceStable(certificates, func(i, j int) bool {
+		return certificates[i].Name < certificates[j].Name
+	})
+
+	out := []interface{}{}
+	for _, certificate := range certificates {
+		if within > 0 {
+			expiration, err := time.Parse(time.RFC3339, certificate.ExpirationDate)
+			if err != nil {
+				log.Printf("[WARN] Certificate %s has an unknown expiration date %q: %v", certificate.Name, certificate.ExpirationDate, err)
+			} else if expiration.After(now.Add(within)) {
+				continue
+			}
+		}
+		out = append(out, map[string]interface{}{
+			"name":            certificate.Name,
+			"expiration_date": certificate.ExpirationDate,
+		})
+	}
+
+	return out
+}
+
+// flattenClusterV2ServingCertificateExpiration returns the expiration of the name serving certificate, PEM encoded at
+// in. It's the cluster level certificate shared by the control plane nodes, their own certificates aren't exposed by
+// the API
+func flattenClusterV2ServingCertificateExpiration(name string, in []byte) (*clusterV2CertificateExpiration, error) {
+	block, _ := pem.Decode(in)
+	if block == nil {
+		return nil, fmt.Errorf("Decoding certificate %s: no PEM data found", name)
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Parsing certificate %s: %w", name, err)
+	}
+
+	return &clusterV2CertificateExpiration{
+		Name:           name,
+		ExpirationDate: certificate.NotAfter.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// Expanders
+
+func expandClusterV2CertificatesExpiration(in map[string]managementClient.CertExpiration) []clusterV2CertificateExpiration {
+	out := make([]clusterV2CertificateExpiration, 0, len(in))
+	for name, certificate := range in {
+		out = append(out, clusterV2CertificateExpiration{
+			Name:           name,
+			ExpirationDate: certificate.ExpirationDate,
+		})
+	}
+
+	return out
+}
+
+func expandClusterV2CertificateRotation(in map[string]interface{}, current *rkev1.RotateCertificates) *rkev1.RotateCertificates {
+	obj := &rkev1.RotateCertificates{
+		Generation: 1,
+	}
+
+	// Changing the generation is the only thing required to initiate a certificates rotation
+	if current != nil {
+		obj.Generation = current.Generation + 1
+	}
+	if v, ok := in["services"].([]interface{}); ok && len(v) > 0 {
+		obj.Services = toArrayStringSorted(v)
+	}
+
+	return obj
+}
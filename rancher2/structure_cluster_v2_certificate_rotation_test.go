@@ -0,0 +1,154 @@
+package rancher2
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testClusterV2CertificatesExpirationConf map[string]managementClient.CertExpiration
+)
+
+func init() {
+	testClusterV2CertificatesExpirationConf = map[string]managementClient.CertExpiration{
+		"kube-node-10.0.0.2": {
+			ExpirationDate: "2024-03-01T00:00:00Z",
+		},
+		"kube-apiserver": {
+			ExpirationDate: "2024-01-15T00:00:00Z",
+		},
+		"kube-etcd-10-0-0-1": {
+			ExpirationDate: "2025-01-01T00:00:00Z",
+		},
+		"kube-proxy": {
+			ExpirationDate: "unknown",
+		},
+	}
+}
+
+func TestFlattenClusterV2CertificatesExpiration(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Input          []clusterV2CertificateExpiration
+		Within         time.Duration
+		ExpectedOutput []interface{}
+	}{
+		{
+			expandClusterV2CertificatesExpiration(testClusterV2CertificatesExpirationConf),
+			0,
+			[]interface{}{
+				map[string]interface{}{
+					"name":            "kube-apiserver",
+					"expiration_date": "2024-01-15T00:00:00Z",
+				},
+				map[string]interface{}{
+					"name":            "kube-etcd-10-0-0-1",
+					"expiration_date": "2025-01-01T00:00:00Z",
+				},
+				map[string]interface{}{
+					"name":            "kube-node-10.0.0.2",
+					"expiration_date": "2024-03-01T00:00:00Z",
+				},
+				map[string]interface{}{
+					"name":            "kube-proxy",
+					"expiration_date": "unknown",
+				},
+			},
+		},
+		{
+			expandClusterV2CertificatesExpiration(testClusterV2CertificatesExpirationConf),
+			30 * 24 * time.Hour,
+			[]interface{}{
+				map[string]interface{}{
+					"name":            "kube-apiserver",
+					"expiration_date": "2024-01-15T00:00:00Z",
+				},
+				map[string]interface{}{
+					"name":            "kube-proxy",
+					"expiration_date": "unknown",
+				},
+			},
+		},
+		{
+			expandClusterV2CertificatesExpiration(nil),
+			30 * 24 * time.Hour,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenClusterV2CertificatesExpiration(tc.Input, tc.Within, now)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestFlattenClusterV2ServingCertificateExpiration(t *testing.T) {
+	notAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "rke2"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"cp-1", "localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.2")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	output, err := flattenClusterV2ServingCertificateExpiration("rke2-serving", certificate)
+	assert.NoError(t, err)
+	assert.Equal(t, &clusterV2CertificateExpiration{Name: "rke2-serving", ExpirationDate: "2025-01-01T00:00:00Z"}, output, "Unexpected output from flattener.")
+
+	_, err = flattenClusterV2ServingCertificateExpiration("rke2-serving", []byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestExpandClusterV2CertificateRotation(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		Current        *rkev1.RotateCertificates
+		ExpectedOutput *rkev1.RotateCertificates
+	}{
+		{
+			map[string]interface{}{},
+			nil,
+			&rkev1.RotateCertificates{
+				Generation: 1,
+			},
+		},
+		{
+			map[string]interface{}{
+				"services": []interface{}{"kube-scheduler", "etcd"},
+			},
+			&rkev1.RotateCertificates{
+				Generation: 2,
+			},
+			&rkev1.RotateCertificates{
+				Generation: 3,
+				Services:   []string{"etcd", "kube-scheduler"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandClusterV2CertificateRotation(tc.Input, tc.Current)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
@@ -0,0 +1,54 @@
+package rancher2
+
+import (
+	"sort"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+)
+
+// Flatteners
+
+// flattenClusterV2ETCDRestoreMachinesRolled returns the sorted names of the machines from before
+// which aren't in after, that is, the machines replaced while restoring
+func flattenClusterV2ETCDRestoreMachinesRolled(before, after []ClusterV2Machine) []interface{} {
+	current := make(map[string]bool, len(after))
+	for _, machine := range after {
+		current[machine.ObjectMeta.Name] = true
+	}
+
+	names := []string{}
+	for _, machine := range before {
+		if !current[machine.ObjectMeta.Name] {
+			names = append(names, machine.ObjectMeta.Name)
+		}
+	}
+	sort.Strings(names)
+
+	return toArrayInterface(names)
+}
+
+// flattenClusterV2ETCDRestoreID returns the restore ID, <cluster_id>:<snapshot_name>
+func flattenClusterV2ETCDRestoreID(clusterID, snapshotName string) string {
+	return clusterID + clusterV2ETCDRestoreIDSeparator + snapshotName
+}
+
+// Expanders
+
+func expandClusterV2ETCDRestore(in map[string]interface{}, current *rkev1.ETCDSnapshotRestore) *rkev1.ETCDSnapshotRestore {
+	obj := &rkev1.ETCDSnapshotRestore{
+		Generation: 1,
+	}
+
+	// Changing the generation is the only thing required to initiate a snapshot restore
+	if current != nil {
+		obj.Generation = current.Generation + 1
+	}
+	if v, ok := in["snapshot_name"].(string); ok && len(v) > 0 {
+		obj.Name = v
+	}
+	if v, ok := in["restore_mode"].(string); ok && len(v) > 0 {
+		obj.RestoreRKEConfig = clusterV2ETCDRestoreModes[v]
+	}
+
+	return obj
+}
@@ -0,0 +1,90 @@
+package rancher2
+
+import (
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testClusterV2ETCDRestoreMachines(names ...string) []ClusterV2Machine {
+	out := make([]ClusterV2Machine, len(names))
+	for i, name := range names {
+		out[i].ObjectMeta = metav1.ObjectMeta{Name: name}
+	}
+	return out
+}
+
+func TestFlattenClusterV2ETCDRestoreMachinesRolled(t *testing.T) {
+
+	cases := []struct {
+		Before         []ClusterV2Machine
+		After          []ClusterV2Machine
+		ExpectedOutput []interface{}
+	}{
+		{
+			testClusterV2ETCDRestoreMachines("pool-c", "pool-a", "pool-b"),
+			testClusterV2ETCDRestoreMachines("pool-b", "pool-d", "pool-e"),
+			[]interface{}{"pool-a", "pool-c"},
+		},
+		{
+			testClusterV2ETCDRestoreMachines("pool-a"),
+			testClusterV2ETCDRestoreMachines("pool-a"),
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenClusterV2ETCDRestoreMachinesRolled(tc.Before, tc.After)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestFlattenClusterV2ETCDRestoreID(t *testing.T) {
+	output := flattenClusterV2ETCDRestoreID("fleet-default/foo", "foo-etcd-snapshot-foo-1-1700000000-local")
+	assert.Equal(t, "fleet-default/foo:foo-etcd-snapshot-foo-1-1700000000-local", output, "Unexpected output from flattener.")
+}
+
+func TestExpandClusterV2ETCDRestore(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		Current        *rkev1.ETCDSnapshotRestore
+		ExpectedOutput *rkev1.ETCDSnapshotRestore
+	}{
+		{
+			map[string]interface{}{
+				"snapshot_name": "snapshot",
+				"restore_mode":  clusterV2ETCDRestoreModeETCDOnly,
+			},
+			nil,
+			&rkev1.ETCDSnapshotRestore{
+				Name:             "snapshot",
+				Generation:       1,
+				RestoreRKEConfig: "none",
+			},
+		},
+		{
+			map[string]interface{}{
+				"snapshot_name": "snapshot",
+				"restore_mode":  clusterV2ETCDRestoreModeKubernetesVersion,
+			},
+			&rkev1.ETCDSnapshotRestore{
+				Name:             "old",
+				Generation:       3,
+				RestoreRKEConfig: "all",
+			},
+			&rkev1.ETCDSnapshotRestore{
+				Name:             "snapshot",
+				Generation:       4,
+				RestoreRKEConfig: "kubernetesVersion",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandClusterV2ETCDRestore(tc.Input, tc.Current)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
@@ -0,0 +1,184 @@
+package rancher2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	clusterV2NodeCommandOSLinux   = "linux"
+	clusterV2NodeCommandOSWindows = "windows"
+)
+
+var (
+	clusterV2NodeCommandOSTypes = []string{clusterV2NodeCommandOSLinux, clusterV2NodeCommandOSWindows}
+)
+
+//Types
+
+type clusterV2NodeCommand struct {
+	OS              string
+	Etcd            bool
+	ControlPlane    bool
+	Worker          bool
+	NodeName        string
+	Address         string
+	InternalAddress string
+	Labels          map[string]string
+	Taints          []corev1.Taint
+}
+
+// quoteShellArg quotes s to be used as a single POSIX shell argument
+func quoteShellArg(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// quotePowershellArg quotes s to be used as a single PowerShell argument
+func quotePowershellArg(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func (n *clusterV2NodeCommand) linuxArgs() []string {
+	args := []string{}
+	if n.Etcd {
+		args = append(args, "--etcd")
+	}
+	if n.ControlPlane {
+		args = append(args, "--controlplane")
+	}
+	if n.Worker {
+		args = append(args, "--worker")
+	}
+	if len(n.NodeName) > 0 {
+		args = append(args, "--node-name", quoteShellArg(n.NodeName))
+	}
+	if len(n.Address) > 0 {
+		args = append(args, "--address", quoteShellArg(n.Address))
+	}
+	if len(n.InternalAddress) > 0 {
+		args = append(args, "--internal-address", quoteShellArg(n.InternalAddress))
+	}
+	for _, label := range n.sortedLabels() {
+		args = append(args, "--label", quoteShellArg(label))
+	}
+	for _, taint := range n.Taints {
+		args = append(args, "--taints", quoteShellArg(taint.ToString()))
+	}
+	return args
+}
+
+func (n *clusterV2NodeCommand) windowsArgs(base string) []string {
+	args := []string{}
+	// Windows node command already includes the worker switch
+	if n.Worker && !strings.Contains(base, "-Worker") {
+		args = append(args, "-Worker")
+	}
+	if len(n.NodeName) > 0 {
+		args = append(args, "-NodeName", quotePowershellArg(n.NodeName))
+	}
+	if len(n.Address) > 0 {
+		args = append(args, "-Address", quotePowershellArg(n.Address))
+	}
+	if len(n.InternalAddress) > 0 {
+		args = append(args, "-InternalAddress", quotePowershellArg(n.InternalAddress))
+	}
+	for _, label := range n.sortedLabels() {
+		args = append(args, "-Label", quotePowershellArg(label))
+	}
+	for _, taint := range n.Taints {
+		args = append(args, "-Taint", quotePowershellArg(taint.ToString()))
+	}
+	return args
+}
+
+func (n *clusterV2NodeCommand) sortedLabels() []string {
+	out := make([]string, 0, len(n.Labels))
+	for k, v := range n.Labels {
+		out = append(out, k+"="+v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Build returns the node registration command, appending node args to the base command
+// from the cluster registration token
+func (n *clusterV2NodeCommand) Build(base string) (string, error) {
+	base = strings.TrimSpace(base)
+	if len(base) == 0 {
+		return "", fmt.Errorf("Building node command: cluster registration token has no %s node command", n.OS)
+	}
+	var args []string
+	switch n.OS {
+	case clusterV2NodeCommandOSLinux:
+		if !n.Etcd && !n.ControlPlane && !n.Worker {
+			return "", fmt.Errorf("Building node command: at least one of etcd, control_plane or worker roles should be set")
+		}
+		args = n.linuxArgs()
+	case clusterV2NodeCommandOSWindows:
+		if n.Etcd || n.ControlPlane {
+			return "", fmt.Errorf("Building node command: windows nodes only support the worker role")
+		}
+		args = n.windowsArgs(base)
+	default:
+		return "", fmt.Errorf("Building node command: unsupported os %q", n.OS)
+	}
+	if len(args) == 0 {
+		return base, nil
+	}
+
+	return base + " " + strings.Join(args, " "), nil
+}
+
+// UserData returns a user-data document running command at first boot. It's a cloud-config
+// document for linux and a cloudbase-init PowerShell script for windows
+func (n *clusterV2NodeCommand) UserData(command string) (string, error) {
+	if n.OS == clusterV2NodeCommandOSWindows {
+		return "#ps1_sysnative\n" + command + "\n", nil
+	}
+	cloudConfig, err := interfaceToGhodssyaml(map[string]interface{}{
+		"runcmd": []interface{}{command},
+	})
+	if err != nil {
+		return "", fmt.Errorf("Building node user data: %v", err)
+	}
+	return "#cloud-config\n" + cloudConfig, nil
+}
+
+// Expanders
+
+func expandClusterV2NodeCommand(in map[string]interface{}) *clusterV2NodeCommand {
+	obj := &clusterV2NodeCommand{}
+
+	if v, ok := in["os"].(string); ok && len(v) > 0 {
+		obj.OS = strings.ToLower(v)
+	}
+	if v, ok := in["etcd"].(bool); ok {
+		obj.Etcd = v
+	}
+	if v, ok := in["control_plane"].(bool); ok {
+		obj.ControlPlane = v
+	}
+	if v, ok := in["worker"].(bool); ok {
+		obj.Worker = v
+	}
+	if v, ok := in["node_name"].(string); ok && len(v) > 0 {
+		obj.NodeName = v
+	}
+	if v, ok := in["address"].(string); ok && len(v) > 0 {
+		obj.Address = v
+	}
+	if v, ok := in["internal_address"].(string); ok && len(v) > 0 {
+		obj.InternalAddress = v
+	}
+	if v, ok := in["labels"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+	if v, ok := in["taints"].([]interface{}); ok && len(v) > 0 {
+		obj.Taints = expandTaintsV2(v)
+	}
+
+	return obj
+}
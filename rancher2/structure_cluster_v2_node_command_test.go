@@ -0,0 +1,166 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	testClusterV2NodeCommandLinuxBase   = "curl -fL https://rancher.example.com/system-agent-install.sh | sudo  sh -s - --server https://rancher.example.com --label 'cattle.io/os=linux' --token token --ca-checksum checksum"
+	testClusterV2NodeCommandWindowsBase = "curl.exe -fL https://rancher.example.com/wins-agent-install.ps1 -o install.ps1; Set-ExecutionPolicy Bypass -Scope Process -Force; ./install.ps1 -Server https://rancher.example.com -Label 'cattle.io/os=windows' -Token token -Worker -CaChecksum checksum"
+)
+
+var (
+	testClusterV2NodeCommandInterface map[string]interface{}
+	testClusterV2NodeCommandConf      *clusterV2NodeCommand
+)
+
+func init() {
+	testClusterV2NodeCommandInterface = map[string]interface{}{
+		"os":               "Linux",
+		"etcd":             true,
+		"control_plane":    true,
+		"worker":           false,
+		"node_name":        "node-1",
+		"address":          "1.2.3.4",
+		"internal_address": "10.0.0.4",
+		"labels": map[string]interface{}{
+			"team": "o'brien",
+			"env":  "prod",
+		},
+		"taints": []interface{}{
+			map[string]interface{}{
+				"key":    "key",
+				"value":  "value",
+				"effect": "NoSchedule",
+			},
+		},
+	}
+	testClusterV2NodeCommandConf = &clusterV2NodeCommand{
+		OS:              clusterV2NodeCommandOSLinux,
+		Etcd:            true,
+		ControlPlane:    true,
+		NodeName:        "node-1",
+		Address:         "1.2.3.4",
+		InternalAddress: "10.0.0.4",
+		Labels: map[string]string{
+			"team": "o'brien",
+			"env":  "prod",
+		},
+		Taints: []corev1.Taint{
+			{
+				Key:    "key",
+				Value:  "value",
+				Effect: corev1.TaintEffectNoSchedule,
+			},
+		},
+	}
+}
+
+func TestExpandClusterV2NodeCommand(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *clusterV2NodeCommand
+	}{
+		{
+			testClusterV2NodeCommandInterface,
+			testClusterV2NodeCommandConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandClusterV2NodeCommand(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
+
+func TestClusterV2NodeCommandBuild(t *testing.T) {
+
+	cases := []struct {
+		Input          *clusterV2NodeCommand
+		Base           string
+		ExpectedOutput string
+		ExpectedErr    bool
+	}{
+		{
+			testClusterV2NodeCommandConf,
+			testClusterV2NodeCommandLinuxBase,
+			testClusterV2NodeCommandLinuxBase + ` --etcd --controlplane --node-name 'node-1' --address '1.2.3.4' --internal-address '10.0.0.4' --label 'env=prod' --label 'team=o'\''brien' --taints 'key=value:NoSchedule'`,
+			false,
+		},
+		{
+			&clusterV2NodeCommand{
+				OS:     clusterV2NodeCommandOSWindows,
+				Worker: true,
+				Labels: map[string]string{
+					"team": "o'brien",
+				},
+				Taints: testClusterV2NodeCommandConf.Taints,
+			},
+			testClusterV2NodeCommandWindowsBase,
+			testClusterV2NodeCommandWindowsBase + ` -Label 'team=o''brien' -Taint 'key=value:NoSchedule'`,
+			false,
+		},
+		{
+			&clusterV2NodeCommand{
+				OS:   clusterV2NodeCommandOSWindows,
+				Etcd: true,
+			},
+			testClusterV2NodeCommandWindowsBase,
+			"",
+			true,
+		},
+		{
+			&clusterV2NodeCommand{
+				OS: clusterV2NodeCommandOSLinux,
+			},
+			testClusterV2NodeCommandLinuxBase,
+			"",
+			true,
+		},
+		{
+			&clusterV2NodeCommand{
+				OS:     clusterV2NodeCommandOSLinux,
+				Worker: true,
+			},
+			"",
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := tc.Input.Build(tc.Base)
+		assert.Equal(t, tc.ExpectedErr, err != nil, "Unexpected error from builder.")
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from builder.")
+	}
+}
+
+func TestClusterV2NodeCommandUserData(t *testing.T) {
+
+	cases := []struct {
+		Input          *clusterV2NodeCommand
+		Command        string
+		ExpectedOutput string
+	}{
+		{
+			&clusterV2NodeCommand{OS: clusterV2NodeCommandOSLinux},
+			"curl -fL https://rancher.example.com/system-agent-install.sh | sh -s - --worker",
+			"#cloud-config\nruncmd:\n- curl -fL https://rancher.example.com/system-agent-install.sh | sh -s - --worker\n",
+		},
+		{
+			&clusterV2NodeCommand{OS: clusterV2NodeCommandOSWindows},
+			"./install.ps1 -Worker",
+			"#ps1_sysnative\n./install.ps1 -Worker\n",
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := tc.Input.UserData(tc.Command)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from user data.")
+	}
+}
@@ -85,6 +85,9 @@ func expandClusterV2RKEConfig(p []interface{}) *provisionv1.RKEConfig {
 		values, _ := ghodssyamlToMapInterface(v)
 		obj.MachineGlobalConfig.Data = values
 	}
+	if v, ok := in["machine_global_settings"].([]interface{}); ok && len(v) > 0 {
+		obj.MachineGlobalConfig.Data = expandClusterV2RKEConfigMachineSettings(v)
+	}
 	if v, ok := in["machine_pools"].([]interface{}); ok && len(v) > 0 {
 		obj.MachinePools = expandClusterV2RKEConfigMachinePools(v)
 	}
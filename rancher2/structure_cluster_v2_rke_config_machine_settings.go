@@ -0,0 +1,183 @@
+package rancher2
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	provisionv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+)
+
+// Flatteners
+
+func flattenClusterV2RKEConfigMachineSettingsValue(in interface{}, current interface{}) string {
+	// Keeping current value if it's the same data written in another yaml format
+	if v, ok := current.(string); ok && len(v) > 0 {
+		var currentValue interface{}
+		if err := ghodssyamlToInterface(v, &currentValue); err == nil && reflect.DeepEqual(currentValue, in) {
+			return v
+		}
+	}
+	if v, ok := in.(string); ok {
+		return v
+	}
+	out, _ := interfaceToJSON(in)
+	return out
+}
+
+func flattenClusterV2RKEConfigMachineSettings(in map[string]interface{}, current []interface{}) []interface{} {
+	obj := make(map[string]interface{})
+
+	currentExtra := map[string]interface{}{}
+	currentExtraYAML := map[string]interface{}{}
+	if len(current) > 0 && current[0] != nil {
+		if v, ok := current[0].(map[string]interface{})["extra_config"].(map[string]interface{}); ok {
+			currentExtra = v
+		}
+		if v, ok := current[0].(map[string]interface{})["extra_config_yaml"].(map[string]interface{}); ok {
+			currentExtraYAML = v
+		}
+	}
+
+	extra := map[string]interface{}{}
+	extraYAML := map[string]interface{}{}
+	for k, v := range in {
+		field := clusterV2RKEConfigMachineSettingsTypedField(k)
+		if _, ok := clusterV2RKEConfigMachineSettingsStringKeys[field]; ok {
+			switch value := v.(type) {
+			case string:
+				obj[field] = value
+			case []interface{}:
+				obj[field] = strings.Join(toArrayString(value), ",")
+			}
+			continue
+		}
+		if _, ok := clusterV2RKEConfigMachineSettingsListKeys[field]; ok {
+			switch value := v.(type) {
+			case string:
+				obj[field] = []interface{}{value}
+			case []interface{}:
+				obj[field] = value
+			}
+			continue
+		}
+		if _, ok := clusterV2RKEConfigMachineSettingsBoolKeys[field]; ok {
+			switch value := v.(type) {
+			case bool:
+				obj[field] = strconv.FormatBool(value)
+			case string:
+				b, _ := strconv.ParseBool(value)
+				obj[field] = strconv.FormatBool(b)
+			}
+			continue
+		}
+		// String values go to extra_config, unless set at extra_config_yaml. Typed values go to extra_config_yaml,
+		// unless set at extra_config, where they are shown as json to report the drift
+		_, isString := v.(string)
+		_, inExtra := currentExtra[k]
+		_, inExtraYAML := currentExtraYAML[k]
+		if inExtraYAML || (!isString && !inExtra) {
+			extraYAML[k] = flattenClusterV2RKEConfigMachineSettingsValue(v, currentExtraYAML[k])
+			continue
+		}
+		if s, ok := v.(string); ok {
+			extra[k] = s
+			continue
+		}
+		extra[k], _ = interfaceToJSON(v)
+	}
+	if len(extra) > 0 {
+		obj["extra_config"] = extra
+	}
+	if len(extraYAML) > 0 {
+		obj["extra_config_yaml"] = extraYAML
+	}
+
+	return []interface{}{obj}
+}
+
+// flattenClusterV2RKEConfigWithMachineSettings flattens rke config, moving machine global and
+// machine selector config data to typed settings when they are used by current config
+func flattenClusterV2RKEConfigWithMachineSettings(in *provisionv1.RKEConfig, current []interface{}) []interface{} {
+	out := flattenClusterV2RKEConfig(in)
+	if len(out) == 0 || len(current) == 0 || current[0] == nil {
+		return out
+	}
+
+	obj := out[0].(map[string]interface{})
+	currentObj := current[0].(map[string]interface{})
+
+	if v, ok := currentObj["machine_global_settings"].([]interface{}); ok && len(v) > 0 {
+		delete(obj, "machine_global_config")
+		obj["machine_global_settings"] = flattenClusterV2RKEConfigMachineSettings(in.MachineGlobalConfig.Data, v)
+	}
+
+	currentSelectors, ok := currentObj["machine_selector_config"].([]interface{})
+	if !ok || len(currentSelectors) == 0 {
+		return out
+	}
+	selectors, ok := obj["machine_selector_config"].([]interface{})
+	if !ok {
+		return out
+	}
+	for i := range selectors {
+		if i >= len(currentSelectors) || currentSelectors[i] == nil {
+			break
+		}
+		settings, ok := currentSelectors[i].(map[string]interface{})["settings"].([]interface{})
+		if !ok || len(settings) == 0 {
+			continue
+		}
+		selector := selectors[i].(map[string]interface{})
+		selector["config"] = clusterV2RKEConfigSystemConfigDefault
+		selector["settings"] = flattenClusterV2RKEConfigMachineSettings(in.MachineSelectorConfig[i].Config.Data, settings)
+	}
+
+	return out
+}
+
+// Expanders
+
+func expandClusterV2RKEConfigMachineSettings(p []interface{}) map[string]interface{} {
+	if p == nil || len(p) == 0 || p[0] == nil {
+		return nil
+	}
+
+	in := p[0].(map[string]interface{})
+	obj := make(map[string]interface{})
+
+	// extra_config values are kept as strings, like "0644" file modes
+	if v, ok := in["extra_config"].(map[string]interface{}); ok && len(v) > 0 {
+		for key, value := range v {
+			obj[key], _ = value.(string)
+		}
+	}
+	if v, ok := in["extra_config_yaml"].(map[string]interface{}); ok && len(v) > 0 {
+		for key, value := range v {
+			s, _ := value.(string)
+			var data interface{}
+			if err := ghodssyamlToInterface(s, &data); err != nil || data == nil {
+				obj[key] = s
+				continue
+			}
+			obj[key] = data
+		}
+	}
+	for field, key := range clusterV2RKEConfigMachineSettingsStringKeys {
+		if v, ok := in[field].(string); ok && len(v) > 0 {
+			obj[key] = v
+		}
+	}
+	for field, key := range clusterV2RKEConfigMachineSettingsListKeys {
+		if v, ok := in[field].([]interface{}); ok && len(v) > 0 {
+			obj[key] = toArrayInterface(toArrayString(v))
+		}
+	}
+	for field, key := range clusterV2RKEConfigMachineSettingsBoolKeys {
+		if v, ok := in[field].(string); ok && len(v) > 0 {
+			obj[key], _ = strconv.ParseBool(v)
+		}
+	}
+
+	return obj
+}
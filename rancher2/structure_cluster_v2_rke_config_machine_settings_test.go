@@ -0,0 +1,329 @@
+package rancher2
+
+import (
+	"testing"
+
+	provisionv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testClusterV2RKEConfigMachineSettingsConf      map[string]interface{}
+	testClusterV2RKEConfigMachineSettingsInterface []interface{}
+)
+
+func init() {
+	testClusterV2RKEConfigMachineSettingsConf = map[string]interface{}{
+		"cni":                     "calico",
+		"profile":                 "cis",
+		"protect-kernel-defaults": true,
+		"disable":                 []interface{}{"rke2-ingress-nginx"},
+		"tls-san":                 []interface{}{"cluster.example.com", "10.0.0.1"},
+		"kube-apiserver-arg":      []interface{}{"audit-log-maxage=30"},
+		"kubelet-arg":             []interface{}{"max-pods=250"},
+		"system-default-registry": "registry.example.com",
+		"etcd-snapshot-retention": float64(10),
+	}
+	testClusterV2RKEConfigMachineSettingsInterface = []interface{}{
+		map[string]interface{}{
+			"cni":                     "calico",
+			"profile":                 "cis",
+			"protect_kernel_defaults": "true",
+			"disable":                 []interface{}{"rke2-ingress-nginx"},
+			"tls_san":                 []interface{}{"cluster.example.com", "10.0.0.1"},
+			"kube_apiserver_arg":      []interface{}{"audit-log-maxage=30"},
+			"kubelet_arg":             []interface{}{"max-pods=250"},
+			"extra_config": map[string]interface{}{
+				"system-default-registry": "registry.example.com",
+			},
+			"extra_config_yaml": map[string]interface{}{
+				"etcd-snapshot-retention": "10",
+			},
+		},
+	}
+}
+
+func TestFlattenClusterV2RKEConfigMachineSettings(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		Current        []interface{}
+		ExpectedOutput []interface{}
+	}{
+		{
+			testClusterV2RKEConfigMachineSettingsConf,
+			nil,
+			testClusterV2RKEConfigMachineSettingsInterface,
+		},
+		{
+			map[string]interface{}{
+				"disable":          "rke2-metrics-server",
+				"etcd-arg":         []interface{}{"quota-backend-bytes=8589934592"},
+				"enable-servicelb": true,
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"extra_config_yaml": map[string]interface{}{
+						"etcd-arg": "[quota-backend-bytes=8589934592]",
+					},
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"disable": []interface{}{"rke2-metrics-server"},
+					"extra_config_yaml": map[string]interface{}{
+						"etcd-arg":         "[quota-backend-bytes=8589934592]",
+						"enable-servicelb": "true",
+					},
+				},
+			},
+		},
+		{
+			map[string]interface{}{
+				"write-kubeconfig-mode": "0644",
+				"etcd-arg":              []interface{}{"quota-backend-bytes=8589934592"},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"extra_config": map[string]interface{}{
+						"write-kubeconfig-mode": "0644",
+						"etcd-arg":              "[quota-backend-bytes=8589934592]",
+					},
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"extra_config": map[string]interface{}{
+						"write-kubeconfig-mode": "0644",
+						"etcd-arg":              `["quota-backend-bytes=8589934592"]`,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenClusterV2RKEConfigMachineSettings(tc.Input, tc.Current)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestFlattenClusterV2RKEConfigWithMachineSettings(t *testing.T) {
+
+	input := &provisionv1.RKEConfig{}
+	input.MachineGlobalConfig = rkev1.GenericMap{
+		Data: testClusterV2RKEConfigMachineSettingsConf,
+	}
+	input.MachineSelectorConfig = []rkev1.RKESystemConfig{
+		{
+			Config: rkev1.GenericMap{
+				Data: map[string]interface{}{
+					"selinux": true,
+				},
+			},
+		},
+	}
+	current := []interface{}{
+		map[string]interface{}{
+			"machine_global_settings": testClusterV2RKEConfigMachineSettingsInterface,
+			"machine_selector_config": []interface{}{
+				map[string]interface{}{
+					"config": clusterV2RKEConfigSystemConfigDefault,
+					"settings": []interface{}{
+						map[string]interface{}{
+							"selinux": "true",
+						},
+					},
+				},
+			},
+		},
+	}
+	output := flattenClusterV2RKEConfigWithMachineSettings(input, current)
+	obj := output[0].(map[string]interface{})
+	assert.NotContains(t, obj, "machine_global_config", "Unexpected output from flattener.")
+	assert.Equal(t, current[0].(map[string]interface{})["machine_global_settings"], obj["machine_global_settings"], "Unexpected output from flattener.")
+	assert.Equal(t, current[0].(map[string]interface{})["machine_selector_config"], obj["machine_selector_config"], "Unexpected output from flattener.")
+
+	output = flattenClusterV2RKEConfigWithMachineSettings(input, nil)
+	assert.Equal(t, flattenClusterV2RKEConfig(input), output, "Unexpected output from flattener.")
+}
+
+func TestExpandClusterV2RKEConfigMachineSettings(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testClusterV2RKEConfigMachineSettingsInterface,
+			testClusterV2RKEConfigMachineSettingsConf,
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"selinux":                 "",
+					"protect_kernel_defaults": "",
+					"kubelet_arg":             []interface{}{},
+				},
+			},
+			map[string]interface{}{},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"selinux":                 "false",
+					"protect_kernel_defaults": "false",
+				},
+			},
+			map[string]interface{}{
+				"selinux":                 false,
+				"protect-kernel-defaults": false,
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"extra_config": map[string]interface{}{
+						"write-kubeconfig-mode":   "0644",
+						"etcd-expose-metrics":     "false",
+						"etcd-snapshot-retention": "10",
+					},
+				},
+			},
+			map[string]interface{}{
+				"write-kubeconfig-mode":   "0644",
+				"etcd-expose-metrics":     "false",
+				"etcd-snapshot-retention": "10",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"extra_config_yaml": map[string]interface{}{
+						"etcd-expose-metrics": "false",
+						"etcd-arg":            "[quota-backend-bytes=8589934592]",
+					},
+				},
+			},
+			map[string]interface{}{
+				"etcd-expose-metrics": false,
+				"etcd-arg":            []interface{}{"quota-backend-bytes=8589934592"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandClusterV2RKEConfigMachineSettings(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
+
+func TestValidateClusterV2RKEConfigMachineSettingsExtraConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		YAML           bool
+		ExpectedErrors int
+	}{
+		{
+			map[string]interface{}{
+				"system-default-registry": "registry.example.com",
+				"etcd-arg":                "[quota-backend-bytes=8589934592]",
+			},
+			true,
+			0,
+		},
+		{
+			map[string]interface{}{
+				"kube-apiserver-args": "[audit-log-maxage=30]",
+				"kube_apiserver_arg":  "[audit-log-maxage=30]",
+			},
+			false,
+			2,
+		},
+		{
+			map[string]interface{}{
+				"cni":      "calico",
+				"etcd-arg": "[quota-backend-bytes",
+			},
+			true,
+			2,
+		},
+		{
+			map[string]interface{}{
+				"cni":      "calico",
+				"etcd-arg": "[quota-backend-bytes",
+			},
+			false,
+			1,
+		},
+	}
+
+	for _, tc := range cases {
+		validate := validateClusterV2RKEConfigMachineSettingsExtraConfig
+		if tc.YAML {
+			validate = validateClusterV2RKEConfigMachineSettingsExtraConfigYAML
+		}
+		_, errs := validate(tc.Input, "extra_config")
+		assert.Len(t, errs, tc.ExpectedErrors, "Unexpected output from validator.")
+	}
+}
+
+func TestValidateClusterV2RKEConfigMachineSettings(t *testing.T) {
+
+	settings := []interface{}{
+		map[string]interface{}{
+			"cni": "calico",
+		},
+	}
+	cases := []struct {
+		Input       []interface{}
+		ExpectedErr bool
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"machine_selector_config": []interface{}{
+						map[string]interface{}{
+							"config":   clusterV2RKEConfigSystemConfigDefault,
+							"settings": settings,
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"machine_selector_config": []interface{}{
+						map[string]interface{}{
+							"config":   "cni: calico",
+							"settings": settings,
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"machine_global_settings": []interface{}{
+						map[string]interface{}{
+							"extra_config":      map[string]interface{}{"write-kubeconfig-mode": "0644"},
+							"extra_config_yaml": map[string]interface{}{"write-kubeconfig-mode": "0644"},
+						},
+					},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateClusterV2RKEConfigMachineSettings(tc.Input)
+		assert.Equal(t, tc.ExpectedErr, err != nil, "Unexpected output from validator.")
+	}
+}
@@ -206,7 +206,9 @@ func expandClusterV2RKEConfigSystemConfig(p []interface{}) []rkev1.RKESystemConf
 		if v, ok := in["machine_label_selector"].([]interface{}); ok && len(v) > 0 {
 			obj.MachineLabelSelector = expandClusterV2RKEConfigSystemConfigLabelSelector(v)
 		}
-		if v, ok := in["config"].(string); ok && len(v) > 0 {
+		if v, ok := in["settings"].([]interface{}); ok && len(v) > 0 {
+			obj.Config.Data = expandClusterV2RKEConfigMachineSettings(v)
+		} else if v, ok := in["config"].(string); ok && len(v) > 0 {
 			values, _ := ghodssyamlToMapInterface(v)
 			obj.Config.Data = values
 		}
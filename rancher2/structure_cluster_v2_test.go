@@ -200,6 +200,11 @@ func TestFlattenClusterV2(t *testing.T) {
 				// This is a hack to remove the deprecated field because it is not being set.
 				rkeConfig := actualOutput[k].([]interface{})[0].(map[string]interface{})
 				delete(rkeConfig, "local_auth_endpoint")
+				// Typed machine settings aren't set either when raw yaml config is used.
+				delete(rkeConfig, "machine_global_settings")
+				for _, selector := range rkeConfig["machine_selector_config"].([]interface{}) {
+					delete(selector.(map[string]interface{}), "settings")
+				}
 			}
 		}
 		assert.Equal(t, tc.ExpectedOutput, actualOutput)
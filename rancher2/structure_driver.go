@@ -0,0 +1,61 @@
+package rancher2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/rancher/norman/types"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+const (
+	driverConditionDownloaded = "Downloaded"
+	driverConditionInstalled  = "Installed"
+)
+
+// Flatteners
+
+// flattenDriverSchemaFields returns the driver dynamic config schema fields, sorted by name
+func flattenDriverSchemaFields(in *types.Schema) []interface{} {
+	if in == nil || len(in.ResourceFields) == 0 {
+		return []interface{}{}
+	}
+
+	names := make([]string, 0, len(in.ResourceFields))
+	for name := range in.ResourceFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		field := in.ResourceFields[name]
+		obj := map[string]interface{}{
+			"name":        name,
+			"type":        field.Type,
+			"description": field.Description,
+			"required":    field.Required,
+			"sensitive":   field.Type == driverConfigPasswordType,
+		}
+		if field.Default != nil {
+			obj["default"] = flattenDriverConfigValue(field.Default)
+		}
+		out = append(out, obj)
+	}
+
+	return out
+}
+
+// getDriverConditionsError returns the Downloaded or Installed driver condition error, if any
+func getDriverConditionsError(conditions []managementClient.Condition) error {
+	for _, condition := range conditions {
+		if condition.Type != driverConditionDownloaded && condition.Type != driverConditionInstalled {
+			continue
+		}
+		if condition.Reason == "Error" || (condition.Status == "False" && len(condition.Message) > 0) {
+			return fmt.Errorf("%s condition failed: %s", condition.Type, condition.Message)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package rancher2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	norman "github.com/rancher/norman/types"
+)
+
+// Flatteners
+
+// flattenDriverConfig sets in values at fields and sensitive_fields as configured at p, or every value at fields if not configured
+func flattenDriverConfig(driver string, in map[string]interface{}, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	obj["driver"] = driver
+
+	fields, fieldsOk := obj["fields"].(map[string]interface{})
+	sensitiveFields, sensitiveOk := obj["sensitive_fields"].(map[string]interface{})
+	if !fieldsOk && !sensitiveOk {
+		fields = make(map[string]interface{}, len(in))
+		for k := range in {
+			fields[k] = ""
+		}
+	}
+	obj["fields"] = flattenDriverConfigFields(in, fields)
+	obj["sensitive_fields"] = flattenDriverConfigFields(in, sensitiveFields)
+
+	return []interface{}{obj}
+}
+
+// flattenDriverConfigFields returns in values, as string, for the keys of configured. Configured value is kept if not
+// returned by the API, like write only password fields
+func flattenDriverConfigFields(in, configured map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(configured))
+	for k, v := range configured {
+		value, ok := in[k]
+		if !ok || value == nil {
+			if s, ok := v.(string); ok && len(s) > 0 {
+				out[k] = s
+			}
+			continue
+		}
+		out[k] = flattenDriverConfigValue(value)
+	}
+
+	return out
+}
+
+func flattenDriverConfigValue(in interface{}) string {
+	switch v := in.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			values = append(values, flattenDriverConfigValue(value))
+		}
+		return strings.Join(values, ",")
+	default:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(out)
+	}
+}
+
+// Expanders
+
+// expandDriverConfig returns driver, fields and sensitive fields from driver config argument
+func expandDriverConfig(p []interface{}) (string, map[string]interface{}, map[string]interface{}) {
+	driver := ""
+	fields := map[string]interface{}{}
+	sensitiveFields := map[string]interface{}{}
+	if len(p) == 0 || p[0] == nil {
+		return driver, fields, sensitiveFields
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["driver"].(string); ok {
+		driver = v
+	}
+	if v, ok := in["fields"].(map[string]interface{}); ok {
+		for k, value := range v {
+			fields[k] = value
+		}
+	}
+	if v, ok := in["sensitive_fields"].(map[string]interface{}); ok {
+		for k, value := range v {
+			sensitiveFields[k] = value
+		}
+	}
+
+	return driver, fields, sensitiveFields
+}
+
+// expandDriverConfigFields validates fields and sensitiveFields against the driver config schema s, coercing their values
+// to the schema field types. Password type fields should be sensitive and reserved fields are not allowed
+func expandDriverConfigFields(driver string, fields, sensitiveFields map[string]interface{}, s *norman.Schema, reserved []string) error {
+	if s == nil {
+		return fmt.Errorf("Expanding driver %s config fields: schema is nil", driver)
+	}
+
+	errs := []string{}
+	coerce := func(in map[string]interface{}, sensitive bool) {
+		for k, v := range in {
+			field, ok := s.ResourceFields[k]
+			if !ok || slices.Contains(reserved, k) {
+				errs = append(errs, fmt.Sprintf("field %q is not supported by driver %s", k, driver))
+				continue
+			}
+			if field.Type == driverConfigPasswordType && !sensitive {
+				errs = append(errs, fmt.Sprintf("field %q is password type, it should be set at sensitive_fields", k))
+				continue
+			}
+			value, err := expandDriverConfigValue(fmt.Sprintf("%v", v), field.Type)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("field %q: %v", k, err))
+				continue
+			}
+			in[k] = value
+		}
+	}
+	coerce(fields, false)
+	coerce(sensitiveFields, true)
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("Expanding driver %s config fields: %s", driver, strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func expandDriverConfigValue(in, fieldType string) (interface{}, error) {
+	switch {
+	case fieldType == "int":
+		return strconv.ParseInt(in, 10, 64)
+	case fieldType == "float":
+		return strconv.ParseFloat(in, 64)
+	case fieldType == "boolean":
+		return strconv.ParseBool(in)
+	case strings.HasPrefix(fieldType, "array["):
+		out := []string{}
+		for _, value := range strings.Split(in, ",") {
+			if value = strings.TrimSpace(value); len(value) > 0 {
+				out = append(out, value)
+			}
+		}
+		return out, nil
+	case strings.HasPrefix(fieldType, "map["):
+		return nil, fmt.Errorf("%s type is not supported", fieldType)
+	default:
+		return in, nil
+	}
+}
+
+// mergeDriverConfigFields returns a new map with fields and sensitiveFields
+func mergeDriverConfigFields(fields, sensitiveFields map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(fields)+len(sensitiveFields))
+	for k, v := range fields {
+		out[k] = v
+	}
+	for k, v := range sensitiveFields {
+		out[k] = v
+	}
+
+	return out
+}
+
+// validateDriverConfig validates key driver config fields at plan time, if known and the driver schema is available
+func validateDriverConfig(d *schema.ResourceDiff, key string, getSchema func(string) (*norman.Schema, error), reserved []string) error {
+	v, ok := d.Get(key).([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	for _, k := range []string{"driver", "fields", "sensitive_fields"} {
+		if !d.NewValueKnown(key + ".0." + k) {
+			return nil
+		}
+	}
+
+	driver, fields, sensitiveFields := expandDriverConfig(v)
+	s, err := getSchema(driver)
+	if err != nil {
+		// Driver could be activated on the same apply
+		log.Printf("[WARN] Skipping driver %s config fields validation: %v", driver, err)
+		return nil
+	}
+
+	return expandDriverConfigFields(driver, fields, sensitiveFields, s, reserved)
+}
@@ -0,0 +1,131 @@
+package rancher2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenDriverConfigFields(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		Configured     map[string]interface{}
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			map[string]interface{}{
+				"cpuCount": float64(2),
+				"image":    "ubuntu",
+				"tags":     []interface{}{"a", "b"},
+			},
+			map[string]interface{}{
+				"cpuCount": "2",
+				"tags":     "a,b",
+				"password": "secret",
+			},
+			map[string]interface{}{
+				"cpuCount": "2",
+				"tags":     "a,b",
+				"password": "secret",
+			},
+		},
+		{
+			map[string]interface{}{
+				"image": "ubuntu",
+			},
+			map[string]interface{}{},
+			map[string]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenDriverConfigFields(tc.Input, tc.Configured)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandDriverConfigValue(t *testing.T) {
+
+	cases := []struct {
+		Input          string
+		Type           string
+		ExpectedOutput interface{}
+		ExpectedErr    bool
+	}{
+		{"2", "int", int64(2), false},
+		{"1.5", "float", float64(1.5), false},
+		{"true", "boolean", true, false},
+		{"a, ,b", "array[string]", []string{"a", "b"}, false},
+		{"foo", "string", "foo", false},
+		{"foo", "int", nil, true},
+		{"a=b", "map[string]", nil, true},
+	}
+
+	for _, tc := range cases {
+		output, err := expandDriverConfigValue(tc.Input, tc.Type)
+		if tc.ExpectedErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
+
+func TestNodeTemplateDriverConfigJSON(t *testing.T) {
+	in := `{"id":"cattle-global-nt:nt-abcde","driver":"nutanix","nutanixConfig":{"image":"ubuntu","cpuCount":2}}`
+	obj := &NodeTemplate{}
+	err := json.Unmarshal([]byte(in), obj)
+	assert.NoError(t, err)
+	assert.Equal(t, "nutanix", obj.Driver)
+	assert.Equal(t, map[string]interface{}{"image": "ubuntu", "cpuCount": float64(2)}, obj.DriverConfig)
+
+	out, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `"nutanixConfig":{"cpuCount":2,"image":"ubuntu"}`)
+}
+
+func TestCloudCredentialDriverConfigJSON(t *testing.T) {
+	in := `{"id":"cattle-global-data:cc-abcde","name":"foo","amazonec2credentialConfig":null,"nutanixcredentialConfig":{"endpoint":"prism.example.com"}}`
+	obj := &CloudCredential{}
+	err := json.Unmarshal([]byte(in), obj)
+	assert.NoError(t, err)
+	assert.Equal(t, "nutanix", obj.Driver)
+	assert.Equal(t, map[string]interface{}{"endpoint": "prism.example.com"}, obj.DriverConfig)
+
+	out, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `"nutanixcredentialConfig":{"endpoint":"prism.example.com"}`)
+}
+
+func TestClusterGenericConfigJSON(t *testing.T) {
+	in := `{"id":"c-abcde","name":"foo","driver":"linodekubernetesengine","okeEngineConfig":null,"rancherKubernetesEngineConfig":{"sshAgentAuth":false},"lkeEngineConfig":{"driverName":"linodekubernetesengine","region":"us-east","nodePoolCount":3}}`
+	obj := &Cluster{}
+	err := json.Unmarshal([]byte(in), obj)
+	assert.NoError(t, err)
+	assert.Equal(t, "lke", obj.GenericConfigDriver)
+	assert.Equal(t, map[string]interface{}{"region": "us-east", "nodePoolCount": float64(3)}, obj.GenericConfig)
+	assert.Nil(t, obj.OracleKubernetesEngineConfig)
+	assert.NotNil(t, obj.RancherKubernetesEngineConfig)
+
+	out, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `"lkeEngineConfig":{"nodePoolCount":3,"region":"us-east"}`)
+
+	// Field is picked from the cluster driver, even if other kontainer driver configs are returned
+	for i := 0; i < 10; i++ {
+		obj = &Cluster{}
+		err = json.Unmarshal([]byte(`{"id":"c-abcde","driver":"lke","aaaEngineConfig":{"region":"eu-west"},"lkeEngineConfig":{"region":"us-east"},"zzzEngineConfig":{"driverName":"lke"}}`), obj)
+		assert.NoError(t, err)
+		assert.Equal(t, "lke", obj.GenericConfigDriver)
+		assert.Equal(t, map[string]interface{}{"region": "us-east"}, obj.GenericConfig)
+	}
+
+	obj = &Cluster{}
+	err = json.Unmarshal([]byte(`{"id":"c-abcde","driver":"imported","okeEngineConfig":null}`), obj)
+	assert.NoError(t, err)
+	assert.Nil(t, obj.GenericConfig)
+	assert.Empty(t, obj.GenericConfigDriver)
+}
@@ -0,0 +1,116 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/rancher/norman/types"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenDriverSchemaFields(t *testing.T) {
+	in := &types.Schema{
+		ResourceFields: map[string]types.Field{
+			"password": {
+				Type: driverConfigPasswordType,
+			},
+			"cpuCount": {
+				Type:        "string",
+				Default:     "2",
+				Description: "CPU count",
+				Required:    true,
+			},
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":        "cpuCount",
+			"type":        "string",
+			"default":     "2",
+			"description": "CPU count",
+			"required":    true,
+			"sensitive":   false,
+		},
+		map[string]interface{}{
+			"name":        "password",
+			"type":        driverConfigPasswordType,
+			"description": "",
+			"required":    false,
+			"sensitive":   true,
+		},
+	}
+
+	output := flattenDriverSchemaFields(in)
+	assert.Equal(t, expected, output, "Unexpected output from flattener.")
+	assert.Equal(t, []interface{}{}, flattenDriverSchemaFields(nil), "Unexpected output from flattener.")
+}
+
+func TestGetDriverConditionsError(t *testing.T) {
+
+	cases := []struct {
+		Input       []managementClient.Condition
+		ExpectedErr string
+	}{
+		{
+			[]managementClient.Condition{
+				{Type: driverConditionDownloaded, Status: "True"},
+				{Type: driverConditionInstalled, Status: "Unknown"},
+			},
+			"",
+		},
+		{
+			[]managementClient.Condition{
+				{Type: driverConditionDownloaded, Status: "Unknown", Reason: "Error", Message: "checksum mismatch"},
+			},
+			"Downloaded condition failed: checksum mismatch",
+		},
+		{
+			[]managementClient.Condition{
+				{Type: driverConditionDownloaded, Status: "True"},
+				{Type: driverConditionInstalled, Status: "False", Message: "exec format error"},
+			},
+			"Installed condition failed: exec format error",
+		},
+		{
+			[]managementClient.Condition{
+				{Type: "Active", Status: "False", Message: "inactive"},
+			},
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		err := getDriverConditionsError(tc.Input)
+		if len(tc.ExpectedErr) == 0 {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tc.ExpectedErr)
+	}
+}
+
+func TestValidateDriverURL(t *testing.T) {
+
+	cases := []struct {
+		URL              string
+		WhitelistDomains []string
+		ExpectedErr      bool
+	}{
+		{"https://github.com/foo/driver.tgz", []string{"github.com"}, false},
+		{"https://objects.github.com/foo/driver.tgz", []string{"*.github.com"}, false},
+		{"https://Objects.GitHub.com:443/foo/driver.tgz", []string{"github.com"}, false},
+		{"http://github.com/foo/driver.tgz", []string{"github.com"}, true},
+		{"https://github.com/foo/driver.tgz", []string{}, true},
+		{"https://evilgithub.com/foo/driver.tgz", []string{"github.com"}, true},
+		{"local://", []string{}, true},
+	}
+
+	for _, tc := range cases {
+		err := validateDriverURL(tc.URL, tc.WhitelistDomains)
+		if tc.ExpectedErr {
+			assert.Error(t, err, "Expected error for url %s", tc.URL)
+			continue
+		}
+		assert.NoError(t, err, "Unexpected error for url %s", tc.URL)
+	}
+}
@@ -0,0 +1,195 @@
+package rancher2
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+// Flatteners
+
+func flattenEffectivePermissionsRules(in []effectivePermissionsRule) []interface{} {
+	out := make([]interface{}, 0, len(in))
+	for _, rule := range in {
+		obj := flattenPolicyRules([]managementClient.PolicyRule{rule.Rule})[0].(map[string]interface{})
+		grants := make([]interface{}, 0, len(rule.GrantedBy))
+		for _, grant := range rule.GrantedBy {
+			grants = append(grants, map[string]interface{}{
+				"binding_type": grant.BindingType,
+				"binding_id":   grant.BindingID,
+				"role_path":    toArrayInterface(grant.RolePath),
+			})
+		}
+		obj["granted_by"] = grants
+		out = append(out, obj)
+	}
+
+	return out
+}
+
+// flattenEffectivePermissionsGroupPrincipalIDs returns the sorted group principal IDs of every auth provider
+// from a user attribute V2 object
+func flattenEffectivePermissionsGroupPrincipalIDs(in map[string]interface{}) []string {
+	out := []string{}
+	providers, ok := in["groupPrincipals"].(map[string]interface{})
+	if !ok {
+		return out
+	}
+	for _, provider := range providers {
+		principals, ok := provider.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		items, ok := principals["items"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			principal, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			metadata, ok := principal["metadata"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, ok := metadata["name"].(string); ok && len(name) > 0 && !slices.Contains(out, name) {
+				out = append(out, name)
+			}
+		}
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+// Expanders
+
+// expandEffectivePermissionsRules returns the rules of roles granted by grant
+func expandEffectivePermissionsRules(rules []managementClient.PolicyRule, grant effectivePermissionsGrant) []effectivePermissionsRule {
+	out := make([]effectivePermissionsRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, effectivePermissionsRule{
+			Rule:      rule,
+			GrantedBy: []effectivePermissionsGrant{grant},
+		})
+	}
+
+	return out
+}
+
+// expandEffectivePermissionsRoleTemplateRules returns the rules of role template id and the role templates it inherits
+// from, recursively. getRoleTemplate is used to get role templates by ID
+func expandEffectivePermissionsRoleTemplateRules(id string, grant effectivePermissionsGrant, getRoleTemplate func(string) (*managementClient.RoleTemplate, error)) ([]effectivePermissionsRule, error) {
+	for _, v := range grant.RolePath {
+		if v == id {
+			// Avoiding loops on role templates inheritance
+			return nil, nil
+		}
+	}
+
+	roleTemplate, err := getRoleTemplate(id)
+	if err != nil {
+		return nil, fmt.Errorf("Getting role template %s: %w", id, err)
+	}
+
+	grant.RolePath = append(append([]string{}, grant.RolePath...), id)
+	rules := roleTemplate.Rules
+	if roleTemplate.External && len(rules) == 0 {
+		rules = roleTemplate.ExternalRules
+	}
+	out := expandEffectivePermissionsRules(rules, grant)
+	for _, inheritedID := range roleTemplate.RoleTemplateIDs {
+		inherited, err := expandEffectivePermissionsRoleTemplateRules(inheritedID, grant, getRoleTemplate)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, inherited...)
+	}
+
+	return out, nil
+}
+
+// mergeEffectivePermissionsRules deduplicates rules, merging the grants of equal rules. Rule fields are sorted
+func mergeEffectivePermissionsRules(in []effectivePermissionsRule) []effectivePermissionsRule {
+	out := []effectivePermissionsRule{}
+	index := map[string]int{}
+	for _, rule := range in {
+		normalized := normalizeEffectivePermissionsPolicyRule(rule.Rule)
+		key := effectivePermissionsPolicyRuleKey(normalized)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(out)
+			out = append(out, effectivePermissionsRule{Rule: normalized})
+			i = len(out) - 1
+		}
+		for _, grant := range rule.GrantedBy {
+			if !isEffectivePermissionsGrantIn(grant, out[i].GrantedBy) {
+				out[i].GrantedBy = append(out[i].GrantedBy, grant)
+			}
+		}
+	}
+
+	return out
+}
+
+func normalizeEffectivePermissionsPolicyRule(in managementClient.PolicyRule) managementClient.PolicyRule {
+	normalize := func(values []string, lower bool) []string {
+		if len(values) == 0 {
+			return nil
+		}
+		out := []string{}
+		for _, v := range values {
+			if lower {
+				v = strings.ToLower(v)
+			}
+			if !slices.Contains(out, v) {
+				out = append(out, v)
+			}
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	return managementClient.PolicyRule{
+		APIGroups:       normalize(in.APIGroups, false),
+		NonResourceURLs: normalize(in.NonResourceURLs, false),
+		ResourceNames:   normalize(in.ResourceNames, false),
+		Resources:       normalize(in.Resources, false),
+		Verbs:           normalize(in.Verbs, true),
+	}
+}
+
+func effectivePermissionsPolicyRuleKey(in managementClient.PolicyRule) string {
+	return strings.Join([]string{
+		strings.Join(in.APIGroups, ","),
+		strings.Join(in.NonResourceURLs, ","),
+		strings.Join(in.ResourceNames, ","),
+		strings.Join(in.Resources, ","),
+		strings.Join(in.Verbs, ","),
+	}, "|")
+}
+
+func isEffectivePermissionsGrantIn(grant effectivePermissionsGrant, list []effectivePermissionsGrant) bool {
+	for _, v := range list {
+		if v.BindingType == grant.BindingType && v.BindingID == grant.BindingID && strings.Join(v.RolePath, ",") == strings.Join(grant.RolePath, ",") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isEffectivePermissionsPrincipal returns true if any of the binding subject ids is one of principalIDs
+func isEffectivePermissionsPrincipal(principalIDs []string, ids ...string) bool {
+	for _, id := range ids {
+		if len(id) > 0 && slices.Contains(principalIDs, id) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,205 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testEffectivePermissionsRoleTemplates map[string]*managementClient.RoleTemplate
+)
+
+func init() {
+	testEffectivePermissionsRoleTemplates = map[string]*managementClient.RoleTemplate{
+		"cluster-member": {
+			Rules: []managementClient.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"namespaces"},
+					Verbs:     []string{"list", "get"},
+				},
+			},
+			RoleTemplateIDs: []string{"projects-view", "loop"},
+		},
+		"projects-view": {
+			Rules: []managementClient.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"namespaces"},
+					Verbs:     []string{"GET", "list"},
+				},
+			},
+		},
+		"loop": {
+			External: true,
+			ExternalRules: []managementClient.PolicyRule{
+				{
+					APIGroups: []string{"apps"},
+					Resources: []string{"deployments"},
+					Verbs:     []string{"delete"},
+				},
+			},
+			RoleTemplateIDs: []string{"cluster-member"},
+		},
+	}
+}
+
+func testGetEffectivePermissionsRoleTemplate(id string) (*managementClient.RoleTemplate, error) {
+	if v, ok := testEffectivePermissionsRoleTemplates[id]; ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+
+func TestExpandEffectivePermissionsRoleTemplateRules(t *testing.T) {
+	grant := effectivePermissionsGrant{
+		BindingType: effectivePermissionsBindingCluster,
+		BindingID:   "c-abcde:crtb-1",
+	}
+	expected := []effectivePermissionsRule{
+		{
+			Rule: testEffectivePermissionsRoleTemplates["cluster-member"].Rules[0],
+			GrantedBy: []effectivePermissionsGrant{
+				{BindingType: effectivePermissionsBindingCluster, BindingID: "c-abcde:crtb-1", RolePath: []string{"cluster-member"}},
+			},
+		},
+		{
+			Rule: testEffectivePermissionsRoleTemplates["projects-view"].Rules[0],
+			GrantedBy: []effectivePermissionsGrant{
+				{BindingType: effectivePermissionsBindingCluster, BindingID: "c-abcde:crtb-1", RolePath: []string{"cluster-member", "projects-view"}},
+			},
+		},
+		{
+			Rule: testEffectivePermissionsRoleTemplates["loop"].ExternalRules[0],
+			GrantedBy: []effectivePermissionsGrant{
+				{BindingType: effectivePermissionsBindingCluster, BindingID: "c-abcde:crtb-1", RolePath: []string{"cluster-member", "loop"}},
+			},
+		},
+	}
+
+	output, err := expandEffectivePermissionsRoleTemplateRules("cluster-member", grant, testGetEffectivePermissionsRoleTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, output, "Unexpected output from expander.")
+
+	_, err = expandEffectivePermissionsRoleTemplateRules("unknown", grant, testGetEffectivePermissionsRoleTemplate)
+	assert.Error(t, err)
+}
+
+func TestMergeEffectivePermissionsRules(t *testing.T) {
+	grant := effectivePermissionsGrant{
+		BindingType: effectivePermissionsBindingCluster,
+		BindingID:   "c-abcde:crtb-1",
+	}
+	input, err := expandEffectivePermissionsRoleTemplateRules("cluster-member", grant, testGetEffectivePermissionsRoleTemplate)
+	assert.NoError(t, err)
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"api_groups": []interface{}{""},
+			"resources":  []interface{}{"namespaces"},
+			"verbs":      []interface{}{"get", "list"},
+			"granted_by": []interface{}{
+				map[string]interface{}{
+					"binding_type": effectivePermissionsBindingCluster,
+					"binding_id":   "c-abcde:crtb-1",
+					"role_path":    []interface{}{"cluster-member"},
+				},
+				map[string]interface{}{
+					"binding_type": effectivePermissionsBindingCluster,
+					"binding_id":   "c-abcde:crtb-1",
+					"role_path":    []interface{}{"cluster-member", "projects-view"},
+				},
+			},
+		},
+		map[string]interface{}{
+			"api_groups": []interface{}{"apps"},
+			"resources":  []interface{}{"deployments"},
+			"verbs":      []interface{}{"delete"},
+			"granted_by": []interface{}{
+				map[string]interface{}{
+					"binding_type": effectivePermissionsBindingCluster,
+					"binding_id":   "c-abcde:crtb-1",
+					"role_path":    []interface{}{"cluster-member", "loop"},
+				},
+			},
+		},
+	}
+
+	output := flattenEffectivePermissionsRules(mergeEffectivePermissionsRules(append(input, input...)))
+	assert.Equal(t, expected, output, "Unexpected output from flattener.")
+}
+
+func TestFlattenEffectivePermissionsGroupPrincipalIDs(t *testing.T) {
+	input := map[string]interface{}{
+		"groupPrincipals": map[string]interface{}{
+			"github": map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"metadata": map[string]interface{}{"name": "github_team://2"}},
+					map[string]interface{}{"metadata": map[string]interface{}{"name": "github_org://1"}},
+				},
+			},
+			"local": map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"metadata": map[string]interface{}{"name": "github_org://1"}},
+				},
+			},
+		},
+	}
+	expected := []string{"github_org://1", "github_team://2"}
+
+	assert.Equal(t, expected, flattenEffectivePermissionsGroupPrincipalIDs(input), "Unexpected output from flattener.")
+	assert.Equal(t, []string{}, flattenEffectivePermissionsGroupPrincipalIDs(map[string]interface{}{}), "Unexpected output from flattener.")
+}
+
+func TestIsEffectivePermissionsPrincipal(t *testing.T) {
+	userAttribute := map[string]interface{}{
+		"groupPrincipals": map[string]interface{}{
+			"github": map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"metadata": map[string]interface{}{"name": "github_team://2"}},
+				},
+			},
+		},
+	}
+	principalIDs := append([]string{"u-abcde", "github_user://3"}, flattenEffectivePermissionsGroupPrincipalIDs(userAttribute)...)
+
+	cases := []struct {
+		Name     string
+		Binding  effectivePermissionsGlobalRoleBinding
+		Expected bool
+	}{
+		{
+			Name:     "user",
+			Binding:  effectivePermissionsGlobalRoleBinding{GlobalRoleBinding: managementClient.GlobalRoleBinding{UserID: "u-abcde"}},
+			Expected: true,
+		},
+		{
+			Name:     "user principal",
+			Binding:  effectivePermissionsGlobalRoleBinding{UserPrincipalID: "github_user://3"},
+			Expected: true,
+		},
+		{
+			Name:     "group",
+			Binding:  effectivePermissionsGlobalRoleBinding{GlobalRoleBinding: managementClient.GlobalRoleBinding{GroupPrincipalID: "github_team://2"}},
+			Expected: true,
+		},
+		{
+			Name:     "other group",
+			Binding:  effectivePermissionsGlobalRoleBinding{GlobalRoleBinding: managementClient.GlobalRoleBinding{GroupPrincipalID: "github_team://4"}},
+			Expected: false,
+		},
+		{
+			Name:     "empty",
+			Binding:  effectivePermissionsGlobalRoleBinding{},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		output := isEffectivePermissionsPrincipal(principalIDs, tc.Binding.UserID, tc.Binding.UserPrincipalID, tc.Binding.GroupPrincipalID)
+		assert.Equal(t, tc.Expected, output, "Unexpected output for %s binding.", tc.Name)
+	}
+}
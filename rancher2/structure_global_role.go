@@ -2,6 +2,8 @@ package rancher2
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
@@ -45,9 +47,52 @@ func flattenGlobalRole(d *schema.ResourceData, in *managementClient.GlobalRole)
 		}
 	}
 
+	v, _ := d.Get("namespaced_rules").([]interface{})
+	err = d.Set("namespaced_rules", flattenGlobalRoleNamespacedRules(in.NamespacedRules, v))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// flattenGlobalRoleNamespacedRules returns namespaced rules in the order configured at p, followed by the not configured
+// ones sorted by namespace
+func flattenGlobalRoleNamespacedRules(in map[string][]managementClient.PolicyRule, p []interface{}) []interface{} {
+	if len(in) == 0 {
+		return []interface{}{}
+	}
+
+	namespaces := make([]string, 0, len(in))
+	for i := range p {
+		obj, ok := p[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		namespace, _ := obj["namespace"].(string)
+		if _, ok := in[namespace]; ok && !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	configured := len(namespaces)
+	for namespace := range in {
+		if !slices.Contains(namespaces[:configured], namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Strings(namespaces[configured:])
+
+	out := make([]interface{}, len(namespaces))
+	for i, namespace := range namespaces {
+		out[i] = map[string]interface{}{
+			"namespace": namespace,
+			"rules":     flattenPolicyRules(in[namespace]),
+		}
+	}
+
+	return out
+}
+
 // Expanders
 
 func expandGlobalRole(in *schema.ResourceData) *managementClient.GlobalRole {
@@ -83,5 +128,26 @@ func expandGlobalRole(in *schema.ResourceData) *managementClient.GlobalRole {
 		obj.InheritedClusterRoles = toArrayString(v)
 	}
 
+	if v, ok := in.Get("namespaced_rules").([]interface{}); ok && len(v) > 0 {
+		obj.NamespacedRules = expandGlobalRoleNamespacedRules(v)
+	}
+
+	return obj
+}
+
+func expandGlobalRoleNamespacedRules(p []interface{}) map[string][]managementClient.PolicyRule {
+	obj := map[string][]managementClient.PolicyRule{}
+
+	for i := range p {
+		in, ok := p[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		namespace, _ := in["namespace"].(string)
+		if v, ok := in["rules"].([]interface{}); ok && len(v) > 0 {
+			obj[namespace] = append(obj[namespace], expandPolicyRules(v)...)
+		}
+	}
+
 	return obj
 }
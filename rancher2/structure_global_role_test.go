@@ -15,6 +15,8 @@ var (
 	testGlobalRoleInterface                          map[string]interface{}
 	testGlobalRoleWithInheritedClusterRolesConf      *managementClient.GlobalRole
 	testGlobalRoleWithInheritedClusterRolesInterface map[string]interface{}
+	testGlobalRoleWithNamespacedRulesConf            *managementClient.GlobalRole
+	testGlobalRoleWithNamespacedRulesInterface       map[string]interface{}
 )
 
 func init() {
@@ -130,6 +132,31 @@ func init() {
 			"cluster-owner",
 		},
 	}
+
+	testGlobalRoleWithNamespacedRulesConf = &managementClient.GlobalRole{
+		Description:    "description",
+		Name:           "name",
+		NewUserDefault: true,
+		NamespacedRules: map[string][]managementClient.PolicyRule{
+			"fleet-default":      testGlobalRolePolicyRulesConf,
+			"cattle-global-data": testGlobalRolePolicyRulesConf,
+		},
+	}
+	testGlobalRoleWithNamespacedRulesInterface = map[string]interface{}{
+		"new_user_default": true,
+		"description":      "description",
+		"name":             "name",
+		"namespaced_rules": []interface{}{
+			map[string]interface{}{
+				"namespace": "cattle-global-data",
+				"rules":     testGlobalRolePolicyRulesInterface,
+			},
+			map[string]interface{}{
+				"namespace": "fleet-default",
+				"rules":     testGlobalRolePolicyRulesInterface,
+			},
+		},
+	}
 }
 
 func TestFlattenGlobalRole(t *testing.T) {
@@ -145,6 +172,10 @@ func TestFlattenGlobalRole(t *testing.T) {
 			testGlobalRoleWithInheritedClusterRolesConf,
 			testGlobalRoleWithInheritedClusterRolesInterface,
 		},
+		{
+			testGlobalRoleWithNamespacedRulesConf,
+			testGlobalRoleWithNamespacedRulesInterface,
+		},
 	}
 
 	for _, tc := range cases {
@@ -174,6 +205,10 @@ func TestExpandGlobalRole(t *testing.T) {
 			testGlobalRoleWithInheritedClusterRolesInterface,
 			testGlobalRoleWithInheritedClusterRolesConf,
 		},
+		{
+			testGlobalRoleWithNamespacedRulesInterface,
+			testGlobalRoleWithNamespacedRulesConf,
+		},
 	}
 
 	for _, tc := range cases {
@@ -182,3 +217,51 @@ func TestExpandGlobalRole(t *testing.T) {
 		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
 	}
 }
+
+func TestFlattenGlobalRoleNamespacedRules(t *testing.T) {
+	in := map[string][]managementClient.PolicyRule{
+		"fleet-default":      testGlobalRolePolicyRulesConf,
+		"cattle-global-data": testGlobalRolePolicyRulesConf,
+		"cattle-system":      testGlobalRolePolicyRulesConf,
+	}
+	configured := []interface{}{
+		map[string]interface{}{"namespace": "fleet-default"},
+		map[string]interface{}{"namespace": "removed"},
+		map[string]interface{}{"namespace": "cattle-system"},
+	}
+
+	output := flattenGlobalRoleNamespacedRules(in, configured)
+	namespaces := []string{}
+	for _, v := range output {
+		namespaces = append(namespaces, v.(map[string]interface{})["namespace"].(string))
+	}
+	assert.Equal(t, []string{"fleet-default", "cattle-system", "cattle-global-data"}, namespaces, "Unexpected namespaces order from flattener.")
+}
+
+func TestValidateGlobalRoleNamespacedRules(t *testing.T) {
+	cases := []struct {
+		Input       []interface{}
+		ExpectedErr bool
+	}{
+		{
+			testGlobalRoleWithNamespacedRulesInterface["namespaced_rules"].([]interface{}),
+			false,
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{"namespace": "fleet-default", "rules": testGlobalRolePolicyRulesInterface},
+				map[string]interface{}{"namespace": "fleet-default", "rules": testGlobalRolePolicyRulesInterface},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateGlobalRoleNamespacedRules(tc.Input)
+		if tc.ExpectedErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,92 @@
+package rancher2
+
+import (
+	"fmt"
+	"net"
+
+	kubeconfig "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+// Flatteners
+
+// flattenKubeconfig builds the kubeconfig to access clusters through the Rancher proxy at url, adding
+// authorized cluster endpoint contexts if ace is true. First cluster context is the current context, the
+// FQDN one if available and ace is true
+func flattenKubeconfig(url, caCerts string, ace bool, clusters []kubeconfigCluster) (*kubeconfig.Config, error) {
+	if len(url) == 0 {
+		return nil, fmt.Errorf("Building kubeconfig: Rancher URL is empty")
+	}
+	if len(clusters) == 0 {
+		return nil, fmt.Errorf("Building kubeconfig: no clusters")
+	}
+
+	obj := &kubeconfig.Config{
+		APIVersion: "v1",
+		Kind:       "Config",
+	}
+	addContext := func(name, user, server string, ca []byte) {
+		obj.Clusters = append(obj.Clusters, kubeconfig.NamedCluster{
+			Name: name,
+			Cluster: kubeconfig.Cluster{
+				Server:                   server,
+				CertificateAuthorityData: ca,
+			},
+		})
+		obj.Contexts = append(obj.Contexts, kubeconfig.NamedContext{
+			Name: name,
+			Context: kubeconfig.Context{
+				Cluster:  name,
+				AuthInfo: user,
+			},
+		})
+	}
+
+	for i, cluster := range clusters {
+		if len(cluster.Name) == 0 {
+			cluster.Name = cluster.ID
+		}
+		obj.AuthInfos = append(obj.AuthInfos, kubeconfig.NamedAuthInfo{
+			Name: cluster.Name,
+			AuthInfo: kubeconfig.AuthInfo{
+				Token: cluster.Token,
+			},
+		})
+
+		var rancherCA []byte
+		if len(caCerts) > 0 {
+			rancherCA = []byte(caCerts)
+		}
+		addContext(cluster.Name, cluster.Name, url+clusterKubeConfigProxyPath+cluster.ID, rancherCA)
+		if i == 0 {
+			obj.CurrentContext = cluster.Name
+		}
+		if !ace {
+			continue
+		}
+
+		if len(cluster.FQDN) > 0 {
+			var fqdnCA []byte
+			if len(cluster.FQDNCACerts) > 0 {
+				fqdnCA = []byte(cluster.FQDNCACerts)
+			}
+			name := cluster.Name + clusterKubeConfigFQDNContextSuffix
+			addContext(name, cluster.Name, "https://"+cluster.FQDN, fqdnCA)
+			if i == 0 {
+				obj.CurrentContext = name
+			}
+		}
+		var nodesCA []byte
+		if len(cluster.NodesCACert) > 0 {
+			ca, err := Base64Decode(cluster.NodesCACert)
+			if err != nil {
+				return nil, fmt.Errorf("Building kubeconfig: decoding cluster %s CA cert: %v", cluster.ID, err)
+			}
+			nodesCA = []byte(ca)
+		}
+		for _, node := range cluster.Nodes {
+			addContext(cluster.Name+"-"+node.Name, cluster.Name, "https://"+net.JoinHostPort(node.Address, kubeconfigACENodePort), nodesCA)
+		}
+	}
+
+	return obj, nil
+}
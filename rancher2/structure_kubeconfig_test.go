@@ -0,0 +1,97 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kubeconfig "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+var (
+	testKubeconfigClustersConf []kubeconfigCluster
+)
+
+func init() {
+	testKubeconfigClustersConf = []kubeconfigCluster{
+		{
+			ID:          "c-m-abcdef",
+			Name:        "foo",
+			Token:       "kubeconfig-u-foo:secret",
+			FQDN:        "foo.example.com",
+			FQDNCACerts: "fqdn_ca",
+			Nodes: []kubeconfigNode{
+				{
+					Name:    "node-1",
+					Address: "10.0.0.1",
+				},
+			},
+			NodesCACert: Base64Encode("nodes_ca"),
+		},
+		{
+			ID:    "c-m-ghijkl",
+			Token: "kubeconfig-u-bar:secret",
+		},
+	}
+}
+
+func TestFlattenKubeconfig(t *testing.T) {
+
+	cases := []struct {
+		ACE            bool
+		ExpectedOutput *kubeconfig.Config
+	}{
+		{
+			false,
+			&kubeconfig.Config{
+				APIVersion: "v1",
+				Kind:       "Config",
+				Clusters: []kubeconfig.NamedCluster{
+					{Name: "foo", Cluster: kubeconfig.Cluster{Server: "https://rancher.example.com/k8s/clusters/c-m-abcdef", CertificateAuthorityData: []byte("rancher_ca")}},
+					{Name: "c-m-ghijkl", Cluster: kubeconfig.Cluster{Server: "https://rancher.example.com/k8s/clusters/c-m-ghijkl", CertificateAuthorityData: []byte("rancher_ca")}},
+				},
+				AuthInfos: []kubeconfig.NamedAuthInfo{
+					{Name: "foo", AuthInfo: kubeconfig.AuthInfo{Token: "kubeconfig-u-foo:secret"}},
+					{Name: "c-m-ghijkl", AuthInfo: kubeconfig.AuthInfo{Token: "kubeconfig-u-bar:secret"}},
+				},
+				Contexts: []kubeconfig.NamedContext{
+					{Name: "foo", Context: kubeconfig.Context{Cluster: "foo", AuthInfo: "foo"}},
+					{Name: "c-m-ghijkl", Context: kubeconfig.Context{Cluster: "c-m-ghijkl", AuthInfo: "c-m-ghijkl"}},
+				},
+				CurrentContext: "foo",
+			},
+		},
+		{
+			true,
+			&kubeconfig.Config{
+				APIVersion: "v1",
+				Kind:       "Config",
+				Clusters: []kubeconfig.NamedCluster{
+					{Name: "foo", Cluster: kubeconfig.Cluster{Server: "https://rancher.example.com/k8s/clusters/c-m-abcdef", CertificateAuthorityData: []byte("rancher_ca")}},
+					{Name: "foo-fqdn", Cluster: kubeconfig.Cluster{Server: "https://foo.example.com", CertificateAuthorityData: []byte("fqdn_ca")}},
+					{Name: "foo-node-1", Cluster: kubeconfig.Cluster{Server: "https://10.0.0.1:6443", CertificateAuthorityData: []byte("nodes_ca")}},
+					{Name: "c-m-ghijkl", Cluster: kubeconfig.Cluster{Server: "https://rancher.example.com/k8s/clusters/c-m-ghijkl", CertificateAuthorityData: []byte("rancher_ca")}},
+				},
+				AuthInfos: []kubeconfig.NamedAuthInfo{
+					{Name: "foo", AuthInfo: kubeconfig.AuthInfo{Token: "kubeconfig-u-foo:secret"}},
+					{Name: "c-m-ghijkl", AuthInfo: kubeconfig.AuthInfo{Token: "kubeconfig-u-bar:secret"}},
+				},
+				Contexts: []kubeconfig.NamedContext{
+					{Name: "foo", Context: kubeconfig.Context{Cluster: "foo", AuthInfo: "foo"}},
+					{Name: "foo-fqdn", Context: kubeconfig.Context{Cluster: "foo-fqdn", AuthInfo: "foo"}},
+					{Name: "foo-node-1", Context: kubeconfig.Context{Cluster: "foo-node-1", AuthInfo: "foo"}},
+					{Name: "c-m-ghijkl", Context: kubeconfig.Context{Cluster: "c-m-ghijkl", AuthInfo: "c-m-ghijkl"}},
+				},
+				CurrentContext: "foo-fqdn",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := flattenKubeconfig("https://rancher.example.com", "rancher_ca", tc.ACE, testKubeconfigClustersConf)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+
+	_, err := flattenKubeconfig("https://rancher.example.com", "", false, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package rancher2
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Flatteners
+
+// flattenListItems filters in by name_regex argument and sets ids, names and itemsKey attributes
+func flattenListItems(d *schema.ResourceData, itemsKey string, in []listItem) error {
+	items, err := filterListItems(in, d.Get("name_regex").(string))
+	if err != nil {
+		return err
+	}
+
+	ids := make([]interface{}, 0, len(items))
+	names := make([]interface{}, 0, len(items))
+	out := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+		names = append(names, item.Name)
+		out = append(out, map[string]interface{}{
+			"id":          item.ID,
+			"name":        item.Name,
+			"annotations": toMapInterface(item.Annotations),
+			"labels":      toMapInterface(item.Labels),
+		})
+	}
+
+	err = d.Set("ids", ids)
+	if err != nil {
+		return err
+	}
+	err = d.Set("names", names)
+	if err != nil {
+		return err
+	}
+
+	return d.Set(itemsKey, out)
+}
+
+// Expanders
+
+// expandListFilters returns v2 API list filters, selecting objects server side by labels argument
+// merged with labels, and by fields
+func expandListFilters(d *schema.ResourceData, labels, fields map[string]interface{}) map[string]interface{} {
+	selectorLabels := map[string]interface{}{}
+	if v, ok := d.Get("labels").(map[string]interface{}); ok {
+		for k, value := range v {
+			selectorLabels[k] = value
+		}
+	}
+	for k, value := range labels {
+		selectorLabels[k] = value
+	}
+
+	filters := map[string]interface{}{}
+	if len(selectorLabels) > 0 {
+		filters["labelSelector"] = expandListSelector(selectorLabels)
+	}
+	if len(fields) > 0 {
+		filters["fieldSelector"] = expandListSelector(fields)
+	}
+
+	return filters
+}
+
+// expandListSelector returns in as a kubernetes label or field selector, sorted by key
+func expandListSelector(in map[string]interface{}) string {
+	keys := make([]string, 0, len(in))
+	for k := range in {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	selector := make([]string, 0, len(keys))
+	for _, k := range keys {
+		selector = append(selector, fmt.Sprintf("%s=%v", k, in[k]))
+	}
+
+	return strings.Join(selector, ",")
+}
+
+// filterListItems returns items whose name matches nameRegex, if not empty, sorted by name
+func filterListItems(in []listItem, nameRegex string) ([]listItem, error) {
+	var re *regexp.Regexp
+	if len(nameRegex) > 0 {
+		var err error
+		re, err = regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("Filtering list: compiling name_regex %q: %v", nameRegex, err)
+		}
+	}
+
+	out := []listItem{}
+	for _, item := range in {
+		if re != nil && !re.MatchString(item.Name) {
+			continue
+		}
+		out = append(out, item)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Name == out[j].Name {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].Name < out[j].Name
+	})
+
+	return out, nil
+}
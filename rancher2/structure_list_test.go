@@ -0,0 +1,137 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testListItems []listItem
+)
+
+func init() {
+	testListItems = []listItem{
+		{
+			ID:     "c-2",
+			Name:   "prod-b",
+			Labels: map[string]string{"env": "prod"},
+		},
+		{
+			ID:     "c-1",
+			Name:   "prod-a",
+			Labels: map[string]string{"env": "prod", "team": "foo"},
+		},
+		{
+			ID:   "c-3",
+			Name: "dev",
+		},
+	}
+}
+
+func TestExpandListSelector(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput string
+	}{
+		{
+			map[string]interface{}{},
+			"",
+		},
+		{
+			map[string]interface{}{
+				"team": "foo",
+				"env":  "prod",
+			},
+			"env=prod,team=foo",
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandListSelector(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
+
+func TestExpandListFilters(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		Labels         map[string]interface{}
+		Fields         map[string]interface{}
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			map[string]interface{}{},
+			nil,
+			nil,
+			map[string]interface{}{},
+		},
+		{
+			map[string]interface{}{
+				"labels": map[string]interface{}{
+					"team": "foo",
+					"env":  "prod",
+				},
+			},
+			nil,
+			nil,
+			map[string]interface{}{
+				"labelSelector": "env=prod,team=foo",
+			},
+		},
+		{
+			map[string]interface{}{
+				"labels": map[string]interface{}{
+					"env": "prod",
+				},
+			},
+			map[string]interface{}{
+				listNamespaceProjectIDLabelKey: "p-xxx",
+			},
+			map[string]interface{}{
+				"metadata.namespace": "c-xxx",
+			},
+			map[string]interface{}{
+				"labelSelector": "env=prod,field.cattle.io/projectId=p-xxx",
+				"fieldSelector": "metadata.namespace=c-xxx",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, listFields("items"), tc.Input)
+		output := expandListFilters(d, tc.Labels, tc.Fields)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
+
+func TestFilterListItems(t *testing.T) {
+
+	cases := []struct {
+		NameRegex   string
+		ExpectedIDs []string
+		ExpectedErr bool
+	}{
+		{"", []string{"c-3", "c-1", "c-2"}, false},
+		{"^prod-", []string{"c-1", "c-2"}, false},
+		{"^staging$", []string{}, false},
+		{"(", nil, true},
+	}
+
+	for _, tc := range cases {
+		output, err := filterListItems(testListItems, tc.NameRegex)
+		if tc.ExpectedErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		ids := []string{}
+		for _, item := range output {
+			ids = append(ids, item.ID)
+		}
+		assert.Equal(t, tc.ExpectedIDs, ids, "Unexpected output from filter.")
+	}
+}
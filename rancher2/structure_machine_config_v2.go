@@ -23,9 +23,13 @@ type machineConfigV2 struct {
 	AzureConfig         *MachineConfigV2Azure         `json:"azureConfig,omitempty" yaml:"azureConfig,omitempty"`
 	DigitaloceanConfig  *MachineConfigV2Digitalocean  `json:"digitaloceanConfig,omitempty" yaml:"digitaloceanConfig,omitempty"`
 	HarvesterConfig     *MachineConfigV2Harvester     `json:"harvesterConfig,omitempty" yaml:"harvesterConfig,omitempty"`
+	HetznerConfig       *MachineConfigV2Hetzner       `json:"hetznerConfig,omitempty" yaml:"hetznerConfig,omitempty"`
 	LinodeConfig        *MachineConfigV2Linode        `json:"linodeConfig,omitempty" yaml:"linodeConfig,omitempty"`
+	OpennebulaConfig    *MachineConfigV2Opennebula    `json:"opennebulaConfig,omitempty" yaml:"opennebulaConfig,omitempty"`
 	OpenstackConfig     *MachineConfigV2Openstack     `json:"openstackConfig,omitempty" yaml:"openstackConfig,omitempty"`
+	OutscaleConfig      *MachineConfigV2Outscale      `json:"outscaleConfig,omitempty" yaml:"outscaleConfig,omitempty"`
 	VmwarevsphereConfig *MachineConfigV2Vmwarevsphere `json:"vmwarevsphereConfig,omitempty" yaml:"vmwarevsphereConfig,omitempty"`
+	GenericConfig       *MachineConfigV2Generic       `json:"-" yaml:"-"`
 }
 
 type MachineConfigV2 struct {
@@ -62,23 +66,45 @@ func flattenMachineConfigV2(d *schema.ResourceData, in *MachineConfigV2) error {
 		if err != nil {
 			return err
 		}
+	case machineConfigV2HetznerKind:
+		err := d.Set("hetzner_config", flattenMachineConfigV2Hetzner(in.HetznerConfig))
+		if err != nil {
+			return err
+		}
 	case machineConfigV2LinodeKind:
 		err := d.Set("linode_config", flattenMachineConfigV2Linode(in.LinodeConfig))
 		if err != nil {
 			return err
 		}
+	case machineConfigV2OpennebulaKind:
+		err := d.Set("opennebula_config", flattenMachineConfigV2Opennebula(in.OpennebulaConfig))
+		if err != nil {
+			return err
+		}
 	case machineConfigV2OpenstackKind:
 		err := d.Set("openstack_config", flattenMachineConfigV2Openstack(in.OpenstackConfig))
 		if err != nil {
 			return err
 		}
+	case machineConfigV2OutscaleKind:
+		err := d.Set("outscale_config", flattenMachineConfigV2Outscale(in.OutscaleConfig))
+		if err != nil {
+			return err
+		}
 	case machineConfigV2VmwarevsphereKind:
 		err := d.Set("vsphere_config", flattenMachineConfigV2Vmwarevsphere(in.VmwarevsphereConfig))
 		if err != nil {
 			return err
 		}
 	default:
-		return fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		if in.GenericConfig == nil {
+			return fmt.Errorf("[ERROR] Unsupported driver on node template: %s", kind)
+		}
+		v, _ := d.Get("generic_config").([]interface{})
+		err := d.Set("generic_config", flattenMachineConfigV2Generic(in.GenericConfig, v))
+		if err != nil {
+			return err
+		}
 	}
 
 	if len(in.ID) > 0 {
@@ -134,15 +160,27 @@ func expandMachineConfigV2(in *schema.ResourceData) *MachineConfigV2 {
 	if v, ok := in.Get("harvester_config").([]interface{}); ok && len(v) > 0 {
 		obj.HarvesterConfig = expandMachineConfigV2Harvester(v, obj)
 	}
+	if v, ok := in.Get("hetzner_config").([]interface{}); ok && len(v) > 0 {
+		obj.HetznerConfig = expandMachineConfigV2Hetzner(v, obj)
+	}
 	if v, ok := in.Get("linode_config").([]interface{}); ok && len(v) > 0 {
 		obj.LinodeConfig = expandMachineConfigV2Linode(v, obj)
 	}
+	if v, ok := in.Get("opennebula_config").([]interface{}); ok && len(v) > 0 {
+		obj.OpennebulaConfig = expandMachineConfigV2Opennebula(v, obj)
+	}
 	if v, ok := in.Get("openstack_config").([]interface{}); ok && len(v) > 0 {
 		obj.OpenstackConfig = expandMachineConfigV2Openstack(v, obj)
 	}
+	if v, ok := in.Get("outscale_config").([]interface{}); ok && len(v) > 0 {
+		obj.OutscaleConfig = expandMachineConfigV2Outscale(v, obj)
+	}
 	if v, ok := in.Get("vsphere_config").([]interface{}); ok && len(v) > 0 {
 		obj.VmwarevsphereConfig = expandMachineConfigV2Vmwarevsphere(v, obj)
 	}
+	if v, ok := in.Get("generic_config").([]interface{}); ok && len(v) > 0 {
+		obj.GenericConfig = expandMachineConfigV2Generic(v, obj)
+	}
 
 	return obj
 }
@@ -0,0 +1,213 @@
+package rancher2
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	norman "github.com/rancher/norman/types"
+)
+
+// machineConfigV2FromNodeTemplate is the machine config V2 equivalent of a node template
+type machineConfigV2FromNodeTemplate struct {
+	Driver             string
+	MachineConfigField string
+	CloudCredentialID  string
+	Fields             map[string]interface{}
+	Warnings           []string
+}
+
+// Flatteners
+
+func flattenMachineConfigV2FromNodeTemplate(d *schema.ResourceData, in *machineConfigV2FromNodeTemplate) error {
+	if in == nil {
+		return nil
+	}
+
+	d.Set("driver", in.Driver)
+	d.Set("machine_config_field", in.MachineConfigField)
+	d.Set("cloud_credential_id", in.CloudCredentialID)
+
+	fields := make(map[string]interface{}, len(in.Fields))
+	for k, v := range in.Fields {
+		fields[k] = flattenDriverConfigValue(v)
+	}
+	err := d.Set("fields", fields)
+	if err != nil {
+		return err
+	}
+
+	fieldsJSON, err := json.Marshal(in.Fields)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Marshalling machine config fields: %v", err)
+	}
+	d.Set("fields_json", string(fieldsJSON))
+
+	return d.Set("warnings", toArrayInterface(in.Warnings))
+}
+
+// Expanders
+
+// expandMachineConfigV2FromNodeTemplate translates the node template driver config to the equivalent
+// machine config V2 fields. Node template arguments without machine config V2 equivalent are reported as warnings.
+// driverSchema is the <driver>Config schema of custom node drivers, used to withhold their password fields
+func expandMachineConfigV2FromNodeTemplate(in *NodeTemplate, driverSchema *norman.Schema) *machineConfigV2FromNodeTemplate {
+	if in == nil {
+		return nil
+	}
+
+	obj := &machineConfigV2FromNodeTemplate{
+		Driver:            in.Driver,
+		CloudCredentialID: in.CloudCredentialID,
+		Fields:            map[string]interface{}{},
+	}
+
+	var ntConfig []interface{}
+	var mcFields map[string]*schema.Schema
+	switch in.Driver {
+	case amazonec2ConfigDriver:
+		obj.MachineConfigField = "amazonec2_config"
+		ntConfig = flattenAmazonec2Config(in.Amazonec2Config)
+		mcFields = machineConfigV2Amazonec2Fields()
+	case azureConfigDriver:
+		obj.MachineConfigField = "azure_config"
+		ntConfig = flattenAzureConfig(in.AzureConfig)
+		mcFields = machineConfigV2AzureFields()
+	case digitaloceanConfigDriver:
+		obj.MachineConfigField = "digitalocean_config"
+		ntConfig = flattenDigitaloceanConfig(in.DigitaloceanConfig)
+		mcFields = machineConfigV2DigitaloceanFields()
+	case harvesterConfigDriver:
+		obj.MachineConfigField = "harvester_config"
+		ntConfig = flattenHarvesterConfig(in.HarvesterConfig)
+		mcFields = machineConfigV2HarvesterFields()
+	case hetznerConfigDriver:
+		obj.MachineConfigField = "hetzner_config"
+		ntConfig = flattenHetznerConfig(in.HetznerConfig)
+		mcFields = machineConfigV2HetznerFields()
+	case linodeConfigDriver:
+		obj.MachineConfigField = "linode_config"
+		ntConfig = flattenLinodeConfig(in.LinodeConfig)
+		mcFields = machineConfigV2LinodeFields()
+	case opennebulaConfigDriver:
+		obj.MachineConfigField = "opennebula_config"
+		ntConfig = flattenOpenNebulaConfig(in.OpennebulaConfig)
+		mcFields = machineConfigV2OpennebulaFields()
+	case openstackConfigDriver:
+		obj.MachineConfigField = "openstack_config"
+		ntConfig = flattenOpenstackConfig(in.OpenstackConfig)
+		mcFields = machineConfigV2OpenstackFields()
+	case outscaleConfigDriver:
+		obj.MachineConfigField = "outscale_config"
+		ntConfig = flattenOutscaleConfig(in.OutscaleConfig)
+		mcFields = machineConfigV2OutscaleFields()
+	case vmwarevsphereConfigDriver:
+		obj.MachineConfigField = "vsphere_config"
+		ntConfig = flattenVsphereConfig(in.VmwarevsphereConfig)
+		mcFields = machineConfigV2VmwarevsphereFields()
+	default:
+		// Custom node drivers are translated as is, their fields are the same on both APIs
+		obj.MachineConfigField = "generic_config"
+		for k, v := range in.DriverConfig {
+			if isMachineConfigV2FromNodeTemplateEmpty(v) {
+				continue
+			}
+			if driverSchema != nil && driverSchema.ResourceFields[k].Type == driverConfigPasswordType {
+				obj.Warnings = append(obj.Warnings, fmt.Sprintf("%s.%s is sensitive and has not been exported, set it on the cloud credential or on %s", obj.MachineConfigField, k, obj.MachineConfigField))
+				continue
+			}
+			obj.Fields[k] = v
+		}
+	}
+
+	if len(ntConfig) > 0 && ntConfig[0] != nil {
+		for k, v := range ntConfig[0].(map[string]interface{}) {
+			field, ok := mcFields[k]
+			if !ok {
+				if !isMachineConfigV2FromNodeTemplateEmpty(v) {
+					obj.Warnings = append(obj.Warnings, fmt.Sprintf("%s.%s has no machine config V2 equivalent and has been ignored", obj.MachineConfigField, k))
+				}
+				continue
+			}
+			value := expandMachineConfigV2FromNodeTemplateValue(v, field.Type)
+			if field.Default != nil {
+				if flattenDriverConfigValue(value) == flattenDriverConfigValue(field.Default) {
+					continue
+				}
+			} else if isMachineConfigV2FromNodeTemplateEmpty(value) {
+				continue
+			}
+			if field.Sensitive {
+				obj.Warnings = append(obj.Warnings, fmt.Sprintf("%s.%s is sensitive and has not been exported, set it on the cloud credential or on %s", obj.MachineConfigField, k, obj.MachineConfigField))
+				continue
+			}
+			obj.Fields[k] = value
+		}
+	}
+
+	obj.Warnings = append(obj.Warnings, machineConfigV2FromNodeTemplateEngineWarnings(in)...)
+	sort.Strings(obj.Warnings)
+
+	return obj
+}
+
+// expandMachineConfigV2FromNodeTemplateValue coerces a node template argument value to the machine config V2 argument type
+func expandMachineConfigV2FromNodeTemplateValue(in interface{}, t schema.ValueType) interface{} {
+	switch t {
+	case schema.TypeString:
+		return flattenDriverConfigValue(in)
+	case schema.TypeList, schema.TypeSet:
+		if v, ok := in.(string); ok {
+			values := []interface{}{}
+			for _, value := range strings.Split(v, ",") {
+				if value = strings.TrimSpace(value); len(value) > 0 {
+					values = append(values, value)
+				}
+			}
+			return values
+		}
+	}
+	return in
+}
+
+// machineConfigV2FromNodeTemplateEngineWarnings reports node template arguments managed at the RKE1 engine
+// or node level, which are not part of machine config V2
+func machineConfigV2FromNodeTemplateEngineWarnings(in *NodeTemplate) []string {
+	warnings := []string{}
+	ignored := map[string]bool{
+		"engine_env":               len(in.EngineEnv) > 0,
+		"engine_insecure_registry": len(in.EngineInsecureRegistry) > 0,
+		"engine_label":             len(in.EngineLabel) > 0,
+		"engine_opt":               len(in.EngineOpt) > 0,
+		"engine_registry_mirror":   len(in.EngineRegistryMirror) > 0,
+		"engine_storage_driver":    len(in.EngineStorageDriver) > 0,
+	}
+	for k, v := range ignored {
+		if v {
+			warnings = append(warnings, fmt.Sprintf("%s is docker engine specific and is not used by RKE2/k3s machines", k))
+		}
+	}
+	if len(in.NodeTaints) > 0 {
+		warnings = append(warnings, "node_taints should be set as rancher2_cluster_v2 machine pool taints")
+	}
+
+	return warnings
+}
+
+func isMachineConfigV2FromNodeTemplateEmpty(in interface{}) bool {
+	switch v := in.(type) {
+	case nil:
+		return true
+	case string:
+		return len(v) == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
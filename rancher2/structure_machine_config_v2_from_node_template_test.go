@@ -0,0 +1,117 @@
+package rancher2
+
+import (
+	"testing"
+
+	norman "github.com/rancher/norman/types"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandMachineConfigV2FromNodeTemplate(t *testing.T) {
+	amazonec2 := &NodeTemplate{
+		Amazonec2Config: &amazonec2Config{
+			Ami:           "ami-12345",
+			InstanceType:  "t3a.medium",
+			Region:        "us-east-1",
+			RootSize:      "32",
+			SecretKey:     "secret",
+			SecurityGroup: []string{"sg1", "sg2"},
+			SSHKeypath:    "/home/user/.ssh/id_rsa",
+		},
+	}
+	amazonec2.Driver = amazonec2ConfigDriver
+	amazonec2.CloudCredentialID = "cattle-global-data:cc-abcde"
+	amazonec2.EngineStorageDriver = "overlay2"
+	amazonec2.NodeTaints = []managementClient.Taint{{Key: "foo", Value: "bar", Effect: "NoSchedule"}}
+
+	hetzner := &NodeTemplate{
+		HetznerConfig: &hetznerConfig{
+			Image:             "ubuntu-22.04",
+			Networks:          []string{"net1", "net2"},
+			ServerLabels:      map[string]string{"env": "prod"},
+			UsePrivateNetwork: true,
+		},
+	}
+	hetzner.Driver = hetznerConfigDriver
+
+	generic := &NodeTemplate{
+		DriverConfig: map[string]interface{}{
+			"cpuCount": float64(2),
+			"image":    "ubuntu",
+			"password": "secret",
+			"subnet":   "",
+		},
+	}
+	generic.Driver = "nutanix"
+	genericSchema := &norman.Schema{
+		ResourceFields: map[string]norman.Field{
+			"cpuCount": {Type: "int"},
+			"image":    {Type: "string"},
+			"password": {Type: driverConfigPasswordType},
+			"subnet":   {Type: "string"},
+		},
+	}
+
+	cases := []struct {
+		Input          *NodeTemplate
+		InputSchema    *norman.Schema
+		ExpectedOutput *machineConfigV2FromNodeTemplate
+	}{
+		{
+			amazonec2,
+			nil,
+			&machineConfigV2FromNodeTemplate{
+				Driver:             amazonec2ConfigDriver,
+				MachineConfigField: "amazonec2_config",
+				CloudCredentialID:  "cattle-global-data:cc-abcde",
+				Fields: map[string]interface{}{
+					"ami":            "ami-12345",
+					"region":         "us-east-1",
+					"root_size":      "32",
+					"security_group": []interface{}{"sg1", "sg2"},
+				},
+				Warnings: []string{
+					"amazonec2_config.secret_key is sensitive and has not been exported, set it on the cloud credential or on amazonec2_config",
+					"amazonec2_config.ssh_keypath has no machine config V2 equivalent and has been ignored",
+					"engine_storage_driver is docker engine specific and is not used by RKE2/k3s machines",
+					"node_taints should be set as rancher2_cluster_v2 machine pool taints",
+				},
+			},
+		},
+		{
+			hetzner,
+			nil,
+			&machineConfigV2FromNodeTemplate{
+				Driver:             hetznerConfigDriver,
+				MachineConfigField: "hetzner_config",
+				Fields: map[string]interface{}{
+					"image":               "ubuntu-22.04",
+					"networks":            []interface{}{"net1", "net2"},
+					"server_labels":       map[string]interface{}{"env": "prod"},
+					"use_private_network": true,
+				},
+			},
+		},
+		{
+			generic,
+			genericSchema,
+			&machineConfigV2FromNodeTemplate{
+				Driver:             "nutanix",
+				MachineConfigField: "generic_config",
+				Fields: map[string]interface{}{
+					"cpuCount": float64(2),
+					"image":    "ubuntu",
+				},
+				Warnings: []string{
+					"generic_config.password is sensitive and has not been exported, set it on the cloud credential or on generic_config",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandMachineConfigV2FromNodeTemplate(tc.Input, tc.InputSchema)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
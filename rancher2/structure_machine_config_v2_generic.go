@@ -0,0 +1,123 @@
+package rancher2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	norman "github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	machineConfigV2GenericAPIVersion    = "rke-machine-config.cattle.io/v1"
+	machineConfigV2GenericAPITypePrefix = "rke-machine-config.cattle.io."
+	machineConfigV2GenericKindSuffix    = "Config"
+)
+
+var (
+	// machineConfigV2GenericReservedFields are object fields not being driver config
+	machineConfigV2GenericReservedFields = []string{"id", "type", "links", "actions", "apiVersion", "kind", "metadata", "status"}
+)
+
+//Types
+
+// MachineConfigV2Generic is a machine config V2 for any node driver. Fields and SensitiveFields are inlined at json
+type MachineConfigV2Generic struct {
+	norman.Resource
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Driver            string                 `json:"-"`
+	Fields            map[string]interface{} `json:"-"`
+	SensitiveFields   map[string]interface{} `json:"-"`
+}
+
+func (m MachineConfigV2Generic) MarshalJSON() ([]byte, error) {
+	out := mergeDriverConfigFields(m.Fields, m.SensitiveFields)
+	out["apiVersion"] = m.TypeMeta.APIVersion
+	out["kind"] = m.TypeMeta.Kind
+	out["metadata"] = m.ObjectMeta
+
+	return json.Marshal(out)
+}
+
+func (m *MachineConfigV2Generic) UnmarshalJSON(data []byte) error {
+	// Avoiding UnmarshalJSON recursion
+	type machineConfigV2Generic MachineConfigV2Generic
+	obj := machineConfigV2Generic{}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	fields := map[string]interface{}{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	for _, k := range machineConfigV2GenericReservedFields {
+		delete(fields, k)
+	}
+
+	*m = MachineConfigV2Generic(obj)
+	m.Driver = getMachineConfigV2GenericDriver(m.TypeMeta.Kind)
+	m.Fields = fields
+
+	return nil
+}
+
+// Flatteners
+
+// flattenMachineConfigV2Generic sets fields and sensitive_fields as configured at p, or every field at fields if not configured
+func flattenMachineConfigV2Generic(in *MachineConfigV2Generic, p []interface{}) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	return flattenDriverConfig(in.Driver, in.Fields, p)
+}
+
+// Expanders
+
+func expandMachineConfigV2Generic(p []interface{}, source *MachineConfigV2) *MachineConfigV2Generic {
+	if p == nil || len(p) == 0 || p[0] == nil {
+		return nil
+	}
+	obj := &MachineConfigV2Generic{}
+
+	if len(source.ID) > 0 {
+		obj.ID = source.ID
+	}
+	obj.Driver, obj.Fields, obj.SensitiveFields = expandDriverConfig(p)
+	obj.TypeMeta.Kind = getMachineConfigV2GenericKind(obj.Driver)
+	obj.TypeMeta.APIVersion = machineConfigV2GenericAPIVersion
+	source.TypeMeta = obj.TypeMeta
+	obj.ObjectMeta = source.ObjectMeta
+
+	return obj
+}
+
+// expandMachineConfigV2GenericFields validates in fields against the driver machine config schema, coercing their values to
+// the schema field types
+func expandMachineConfigV2GenericFields(in *MachineConfigV2Generic, s *norman.Schema) error {
+	if in == nil {
+		return fmt.Errorf("Expanding machine config V2 generic fields: config is nil")
+	}
+
+	return expandDriverConfigFields(in.Driver, in.Fields, in.SensitiveFields, s, machineConfigV2GenericReservedFields)
+}
+
+func getMachineConfigV2GenericAPIType(driver string) string {
+	return machineConfigV2GenericAPITypePrefix + strings.ToLower(driver) + strings.ToLower(machineConfigV2GenericKindSuffix)
+}
+
+func getMachineConfigV2GenericDriver(kind string) string {
+	return strings.ToLower(strings.TrimSuffix(kind, machineConfigV2GenericKindSuffix))
+}
+
+// getMachineConfigV2GenericKind returns the kind generated by Rancher for the driver machine config
+func getMachineConfigV2GenericKind(driver string) string {
+	if len(driver) == 0 {
+		return ""
+	}
+	return strings.ToUpper(driver[:1]) + driver[1:] + machineConfigV2GenericKindSuffix
+}
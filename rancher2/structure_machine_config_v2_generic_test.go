@@ -0,0 +1,212 @@
+package rancher2
+
+import (
+	"encoding/json"
+	"testing"
+
+	norman "github.com/rancher/norman/types"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	testMachineConfigV2GenericSchema *norman.Schema
+	testMachineConfigV2GenericConf   *MachineConfigV2Generic
+	testMachineConfigV2GenericJSON   string
+)
+
+func init() {
+	testMachineConfigV2GenericSchema = &norman.Schema{
+		ResourceFields: map[string]norman.Field{
+			"apiKey":   {Type: "password"},
+			"cpuCount": {Type: "int"},
+			"image":    {Type: "string"},
+			"publicIp": {Type: "boolean"},
+			"tags":     {Type: "array[string]"},
+			"metadata": {Type: "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"},
+		},
+	}
+	testMachineConfigV2GenericConf = &MachineConfigV2Generic{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NutanixConfig",
+			APIVersion: machineConfigV2GenericAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nc-foo-abcde",
+			Namespace: "fleet-default",
+		},
+		Driver: "nutanix",
+		Fields: map[string]interface{}{
+			"cpuCount": float64(2),
+			"image":    "ubuntu",
+			"publicIp": true,
+			"tags":     []interface{}{"a", "b"},
+			"apiKey":   "secret",
+		},
+	}
+	testMachineConfigV2GenericJSON = `{"id":"fleet-default/nc-foo-abcde","type":"rke-machine-config.cattle.io.nutanixconfig","apiVersion":"rke-machine-config.cattle.io/v1","kind":"NutanixConfig","metadata":{"name":"nc-foo-abcde","namespace":"fleet-default"},"cpuCount":2,"image":"ubuntu","publicIp":true,"tags":["a","b"],"apiKey":"secret"}`
+}
+
+func TestMachineConfigV2GenericJSON(t *testing.T) {
+	obj := &MachineConfigV2Generic{}
+	err := json.Unmarshal([]byte(testMachineConfigV2GenericJSON), obj)
+	assert.NoError(t, err)
+
+	expected := *testMachineConfigV2GenericConf
+	expected.ID = "fleet-default/nc-foo-abcde"
+	expected.Type = "rke-machine-config.cattle.io.nutanixconfig"
+	assert.Equal(t, &expected, obj, "Unexpected output from unmarshal.")
+
+	out, err := json.Marshal(&MachineConfigV2Generic{
+		TypeMeta:        testMachineConfigV2GenericConf.TypeMeta,
+		ObjectMeta:      testMachineConfigV2GenericConf.ObjectMeta,
+		Fields:          map[string]interface{}{"image": "ubuntu"},
+		SensitiveFields: map[string]interface{}{"apiKey": "secret"},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"apiVersion":"rke-machine-config.cattle.io/v1","kind":"NutanixConfig","metadata":{"name":"nc-foo-abcde","namespace":"fleet-default","creationTimestamp":null},"image":"ubuntu","apiKey":"secret"}`, string(out), "Unexpected output from marshal.")
+}
+
+func TestFlattenMachineConfigV2Generic(t *testing.T) {
+
+	cases := []struct {
+		Input          *MachineConfigV2Generic
+		Config         []interface{}
+		ExpectedOutput []interface{}
+	}{
+		{
+			testMachineConfigV2GenericConf,
+			[]interface{}{
+				map[string]interface{}{
+					"driver": "nutanix",
+					"fields": map[string]interface{}{
+						"cpuCount": "2",
+						"publicIp": "true",
+						"tags":     "a,b",
+					},
+					"sensitive_fields": map[string]interface{}{
+						"apiKey": "secret",
+					},
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"driver": "nutanix",
+					"fields": map[string]interface{}{
+						"cpuCount": "2",
+						"publicIp": "true",
+						"tags":     "a,b",
+					},
+					"sensitive_fields": map[string]interface{}{
+						"apiKey": "secret",
+					},
+				},
+			},
+		},
+		{
+			testMachineConfigV2GenericConf,
+			nil,
+			[]interface{}{
+				map[string]interface{}{
+					"driver": "nutanix",
+					"fields": map[string]interface{}{
+						"apiKey":   "secret",
+						"cpuCount": "2",
+						"image":    "ubuntu",
+						"publicIp": "true",
+						"tags":     "a,b",
+					},
+					"sensitive_fields": map[string]interface{}{},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenMachineConfigV2Generic(tc.Input, tc.Config)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandMachineConfigV2GenericFields(t *testing.T) {
+
+	cases := []struct {
+		Fields                  map[string]interface{}
+		SensitiveFields         map[string]interface{}
+		ExpectedFields          map[string]interface{}
+		ExpectedSensitiveFields map[string]interface{}
+		ExpectedErr             bool
+	}{
+		{
+			map[string]interface{}{
+				"cpuCount": "2",
+				"image":    "ubuntu",
+				"publicIp": "true",
+				"tags":     "a, b",
+			},
+			map[string]interface{}{
+				"apiKey": "secret",
+			},
+			map[string]interface{}{
+				"cpuCount": int64(2),
+				"image":    "ubuntu",
+				"publicIp": true,
+				"tags":     []string{"a", "b"},
+			},
+			map[string]interface{}{
+				"apiKey": "secret",
+			},
+			false,
+		},
+		{
+			map[string]interface{}{
+				"apiKey": "secret",
+			},
+			map[string]interface{}{},
+			nil,
+			nil,
+			true,
+		},
+		{
+			map[string]interface{}{
+				"cpuCount": "two",
+			},
+			map[string]interface{}{},
+			nil,
+			nil,
+			true,
+		},
+		{
+			map[string]interface{}{
+				"unknown":  "foo",
+				"metadata": "foo",
+			},
+			map[string]interface{}{},
+			nil,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		obj := &MachineConfigV2Generic{
+			Driver:          "nutanix",
+			Fields:          tc.Fields,
+			SensitiveFields: tc.SensitiveFields,
+		}
+		err := expandMachineConfigV2GenericFields(obj, testMachineConfigV2GenericSchema)
+		if tc.ExpectedErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedFields, obj.Fields, "Unexpected fields from expander.")
+		assert.Equal(t, tc.ExpectedSensitiveFields, obj.SensitiveFields, "Unexpected sensitive fields from expander.")
+	}
+}
+
+func TestGetMachineConfigV2GenericKind(t *testing.T) {
+	assert.Equal(t, "NutanixConfig", getMachineConfigV2GenericKind("nutanix"))
+	assert.Equal(t, "nutanix", getMachineConfigV2GenericDriver("NutanixConfig"))
+	assert.Equal(t, "rke-machine-config.cattle.io.nutanixconfig", getMachineConfigV2GenericAPIType("nutanix"))
+}
@@ -0,0 +1,151 @@
+package rancher2
+
+import (
+	"sort"
+	"strings"
+
+	norman "github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	machineConfigV2HetznerKind         = "HetznerConfig"
+	machineConfigV2HetznerAPIVersion   = "rke-machine-config.cattle.io/v1"
+	machineConfigV2HetznerAPIType      = "rke-machine-config.cattle.io.hetznerconfig"
+	machineConfigV2HetznerClusterIDsep = "."
+	machineConfigV2HetznerLabelSep     = "="
+)
+
+//Types
+
+type machineConfigV2Hetzner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	APIToken          string   `json:"apiToken,omitempty" yaml:"apiToken,omitempty"`
+	Image             string   `json:"image,omitempty" yaml:"image,omitempty"`
+	Networks          []string `json:"networks,omitempty" yaml:"networks,omitempty"`
+	ServerLabel       []string `json:"serverLabel,omitempty" yaml:"serverLabel,omitempty"`
+	ServerLocation    string   `json:"serverLocation,omitempty" yaml:"serverLocation,omitempty"`
+	ServerType        string   `json:"serverType,omitempty" yaml:"serverType,omitempty"`
+	UsePrivateNetwork bool     `json:"usePrivateNetwork,omitempty" yaml:"usePrivateNetwork,omitempty"`
+	UserData          string   `json:"userData,omitempty" yaml:"userData,omitempty"`
+	Volumes           []string `json:"volumes,omitempty" yaml:"volumes,omitempty"`
+}
+
+type MachineConfigV2Hetzner struct {
+	norman.Resource
+	machineConfigV2Hetzner
+}
+
+// Flatteners
+
+func flattenMachineConfigV2Hetzner(in *MachineConfigV2Hetzner) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	obj := make(map[string]interface{})
+
+	if len(in.APIToken) > 0 {
+		obj["api_token"] = in.APIToken
+	}
+
+	if len(in.Image) > 0 {
+		obj["image"] = in.Image
+	}
+
+	if len(in.Networks) > 0 {
+		obj["networks"] = toArrayInterface(in.Networks)
+	}
+
+	if len(in.ServerLabel) > 0 {
+		labels := make(map[string]interface{}, len(in.ServerLabel))
+		for _, label := range in.ServerLabel {
+			k, v, _ := strings.Cut(label, machineConfigV2HetznerLabelSep)
+			labels[k] = v
+		}
+		obj["server_labels"] = labels
+	}
+
+	if len(in.ServerLocation) > 0 {
+		obj["server_location"] = in.ServerLocation
+	}
+
+	if len(in.ServerType) > 0 {
+		obj["server_type"] = in.ServerType
+	}
+
+	obj["use_private_network"] = in.UsePrivateNetwork
+
+	if len(in.UserData) > 0 {
+		obj["userdata"] = in.UserData
+	}
+
+	if len(in.Volumes) > 0 {
+		obj["volumes"] = toArrayInterface(in.Volumes)
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandMachineConfigV2Hetzner(p []interface{}, source *MachineConfigV2) *MachineConfigV2Hetzner {
+	if p == nil || len(p) == 0 || p[0] == nil {
+		return nil
+	}
+	obj := &MachineConfigV2Hetzner{}
+
+	if len(source.ID) > 0 {
+		obj.ID = source.ID
+	}
+	in := p[0].(map[string]interface{})
+
+	obj.TypeMeta.Kind = machineConfigV2HetznerKind
+	obj.TypeMeta.APIVersion = machineConfigV2HetznerAPIVersion
+	source.TypeMeta = obj.TypeMeta
+	obj.ObjectMeta = source.ObjectMeta
+
+	if v, ok := in["api_token"].(string); ok && len(v) > 0 {
+		obj.APIToken = v
+	}
+
+	if v, ok := in["image"].(string); ok && len(v) > 0 {
+		obj.Image = v
+	}
+
+	if v, ok := in["networks"].([]interface{}); ok && len(v) > 0 {
+		obj.Networks = toArrayString(v)
+	}
+
+	if v, ok := in["server_labels"].(map[string]interface{}); ok && len(v) > 0 {
+		labels := make([]string, 0, len(v))
+		for key, value := range toMapString(v) {
+			labels = append(labels, key+machineConfigV2HetznerLabelSep+value)
+		}
+		sort.Strings(labels)
+		obj.ServerLabel = labels
+	}
+
+	if v, ok := in["server_location"].(string); ok && len(v) > 0 {
+		obj.ServerLocation = v
+	}
+
+	if v, ok := in["server_type"].(string); ok && len(v) > 0 {
+		obj.ServerType = v
+	}
+
+	if v, ok := in["use_private_network"].(bool); ok {
+		obj.UsePrivateNetwork = v
+	}
+
+	if v, ok := in["userdata"].(string); ok && len(v) > 0 {
+		obj.UserData = v
+	}
+
+	if v, ok := in["volumes"].([]interface{}); ok && len(v) > 0 {
+		obj.Volumes = toArrayString(v)
+	}
+
+	return obj
+}
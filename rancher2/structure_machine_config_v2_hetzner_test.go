@@ -0,0 +1,79 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testMachineConfigV2HetznerConf      *MachineConfigV2Hetzner
+	testMachineConfigV2HetznerInterface []interface{}
+)
+
+func init() {
+	testMachineConfigV2HetznerConf = &MachineConfigV2Hetzner{}
+	testMachineConfigV2HetznerConf.TypeMeta.Kind = machineConfigV2HetznerKind
+	testMachineConfigV2HetznerConf.TypeMeta.APIVersion = machineConfigV2HetznerAPIVersion
+	testMachineConfigV2HetznerConf.APIToken = "api_token"
+	testMachineConfigV2HetznerConf.Image = "ubuntu-22.04"
+	testMachineConfigV2HetznerConf.Networks = []string{"net1", "net2"}
+	testMachineConfigV2HetznerConf.ServerLabel = []string{"env=prod", "team=foo"}
+	testMachineConfigV2HetznerConf.ServerLocation = "fsn1"
+	testMachineConfigV2HetznerConf.ServerType = "cx21"
+	testMachineConfigV2HetznerConf.UsePrivateNetwork = true
+	testMachineConfigV2HetznerConf.UserData = "userdata"
+	testMachineConfigV2HetznerConf.Volumes = []string{"vol1"}
+	testMachineConfigV2HetznerInterface = []interface{}{
+		map[string]interface{}{
+			"api_token": "api_token",
+			"image":     "ubuntu-22.04",
+			"networks":  []interface{}{"net1", "net2"},
+			"server_labels": map[string]interface{}{
+				"env":  "prod",
+				"team": "foo",
+			},
+			"server_location":     "fsn1",
+			"server_type":         "cx21",
+			"use_private_network": true,
+			"userdata":            "userdata",
+			"volumes":             []interface{}{"vol1"},
+		},
+	}
+}
+
+func TestFlattenMachineConfigV2Hetzner(t *testing.T) {
+
+	cases := []struct {
+		Input          *MachineConfigV2Hetzner
+		ExpectedOutput []interface{}
+	}{
+		{
+			testMachineConfigV2HetznerConf,
+			testMachineConfigV2HetznerInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenMachineConfigV2Hetzner(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandMachineConfigV2Hetzner(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *MachineConfigV2Hetzner
+	}{
+		{
+			testMachineConfigV2HetznerInterface,
+			testMachineConfigV2HetznerConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandMachineConfigV2Hetzner(tc.Input, &MachineConfigV2{})
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
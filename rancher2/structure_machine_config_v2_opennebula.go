@@ -0,0 +1,227 @@
+package rancher2
+
+import (
+	norman "github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	machineConfigV2OpennebulaKind         = "OpennebulaConfig"
+	machineConfigV2OpennebulaAPIVersion   = "rke-machine-config.cattle.io/v1"
+	machineConfigV2OpennebulaAPIType      = "rke-machine-config.cattle.io.opennebulaconfig"
+	machineConfigV2OpennebulaClusterIDsep = "."
+)
+
+//Types
+
+type machineConfigV2Opennebula struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	B2dSize           string `json:"b2dSize,omitempty" yaml:"b2dSize,omitempty"`
+	CPU               string `json:"cpu,omitempty" yaml:"cpu,omitempty"`
+	DevPrefix         string `json:"devPrefix,omitempty" yaml:"devPrefix,omitempty"`
+	DisableVnc        bool   `json:"disableVnc,omitempty" yaml:"disableVnc,omitempty"`
+	DiskResize        string `json:"diskResize,omitempty" yaml:"diskResize,omitempty"`
+	ImageID           string `json:"imageId,omitempty" yaml:"imageId,omitempty"`
+	ImageName         string `json:"imageName,omitempty" yaml:"imageName,omitempty"`
+	ImageOwner        string `json:"imageOwner,omitempty" yaml:"imageOwner,omitempty"`
+	Memory            string `json:"memory,omitempty" yaml:"memory,omitempty"`
+	NetworkID         string `json:"networkId,omitempty" yaml:"networkId,omitempty"`
+	NetworkName       string `json:"networkName,omitempty" yaml:"networkName,omitempty"`
+	NetworkOwner      string `json:"networkOwner,omitempty" yaml:"networkOwner,omitempty"`
+	Password          string `json:"password,omitempty" yaml:"password,omitempty"`
+	SSHUser           string `json:"sshUser,omitempty" yaml:"sshUser,omitempty"`
+	TemplateID        string `json:"templateId,omitempty" yaml:"templateId,omitempty"`
+	TemplateName      string `json:"templateName,omitempty" yaml:"templateName,omitempty"`
+	User              string `json:"user,omitempty" yaml:"user,omitempty"`
+	Vcpu              string `json:"vcpu,omitempty" yaml:"vcpu,omitempty"`
+	XMLRPCURL         string `json:"xmlrpcurl,omitempty" yaml:"xmlrpcurl,omitempty"`
+}
+
+type MachineConfigV2Opennebula struct {
+	norman.Resource
+	machineConfigV2Opennebula
+}
+
+// Flatteners
+
+func flattenMachineConfigV2Opennebula(in *MachineConfigV2Opennebula) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	obj := make(map[string]interface{})
+
+	if len(in.B2dSize) > 0 {
+		obj["b2d_size"] = in.B2dSize
+	}
+
+	if len(in.CPU) > 0 {
+		obj["cpu"] = in.CPU
+	}
+
+	if len(in.DevPrefix) > 0 {
+		obj["dev_prefix"] = in.DevPrefix
+	}
+
+	obj["disable_vnc"] = in.DisableVnc
+
+	if len(in.DiskResize) > 0 {
+		obj["disk_resize"] = in.DiskResize
+	}
+
+	if len(in.ImageID) > 0 {
+		obj["image_id"] = in.ImageID
+	}
+
+	if len(in.ImageName) > 0 {
+		obj["image_name"] = in.ImageName
+	}
+
+	if len(in.ImageOwner) > 0 {
+		obj["image_owner"] = in.ImageOwner
+	}
+
+	if len(in.Memory) > 0 {
+		obj["memory"] = in.Memory
+	}
+
+	if len(in.NetworkID) > 0 {
+		obj["network_id"] = in.NetworkID
+	}
+
+	if len(in.NetworkName) > 0 {
+		obj["network_name"] = in.NetworkName
+	}
+
+	if len(in.NetworkOwner) > 0 {
+		obj["network_owner"] = in.NetworkOwner
+	}
+
+	if len(in.Password) > 0 {
+		obj["password"] = in.Password
+	}
+
+	if len(in.SSHUser) > 0 {
+		obj["ssh_user"] = in.SSHUser
+	}
+
+	if len(in.TemplateID) > 0 {
+		obj["template_id"] = in.TemplateID
+	}
+
+	if len(in.TemplateName) > 0 {
+		obj["template_name"] = in.TemplateName
+	}
+
+	if len(in.User) > 0 {
+		obj["user"] = in.User
+	}
+
+	if len(in.Vcpu) > 0 {
+		obj["vcpu"] = in.Vcpu
+	}
+
+	if len(in.XMLRPCURL) > 0 {
+		obj["xml_rpc_url"] = in.XMLRPCURL
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandMachineConfigV2Opennebula(p []interface{}, source *MachineConfigV2) *MachineConfigV2Opennebula {
+	if p == nil || len(p) == 0 || p[0] == nil {
+		return nil
+	}
+	obj := &MachineConfigV2Opennebula{}
+
+	if len(source.ID) > 0 {
+		obj.ID = source.ID
+	}
+	in := p[0].(map[string]interface{})
+
+	obj.TypeMeta.Kind = machineConfigV2OpennebulaKind
+	obj.TypeMeta.APIVersion = machineConfigV2OpennebulaAPIVersion
+	source.TypeMeta = obj.TypeMeta
+	obj.ObjectMeta = source.ObjectMeta
+
+	if v, ok := in["b2d_size"].(string); ok && len(v) > 0 {
+		obj.B2dSize = v
+	}
+
+	if v, ok := in["cpu"].(string); ok && len(v) > 0 {
+		obj.CPU = v
+	}
+
+	if v, ok := in["dev_prefix"].(string); ok && len(v) > 0 {
+		obj.DevPrefix = v
+	}
+
+	if v, ok := in["disable_vnc"].(bool); ok {
+		obj.DisableVnc = v
+	}
+
+	if v, ok := in["disk_resize"].(string); ok && len(v) > 0 {
+		obj.DiskResize = v
+	}
+
+	if v, ok := in["image_id"].(string); ok && len(v) > 0 {
+		obj.ImageID = v
+	}
+
+	if v, ok := in["image_name"].(string); ok && len(v) > 0 {
+		obj.ImageName = v
+	}
+
+	if v, ok := in["image_owner"].(string); ok && len(v) > 0 {
+		obj.ImageOwner = v
+	}
+
+	if v, ok := in["memory"].(string); ok && len(v) > 0 {
+		obj.Memory = v
+	}
+
+	if v, ok := in["network_id"].(string); ok && len(v) > 0 {
+		obj.NetworkID = v
+	}
+
+	if v, ok := in["network_name"].(string); ok && len(v) > 0 {
+		obj.NetworkName = v
+	}
+
+	if v, ok := in["network_owner"].(string); ok && len(v) > 0 {
+		obj.NetworkOwner = v
+	}
+
+	if v, ok := in["password"].(string); ok && len(v) > 0 {
+		obj.Password = v
+	}
+
+	if v, ok := in["ssh_user"].(string); ok && len(v) > 0 {
+		obj.SSHUser = v
+	}
+
+	if v, ok := in["template_id"].(string); ok && len(v) > 0 {
+		obj.TemplateID = v
+	}
+
+	if v, ok := in["template_name"].(string); ok && len(v) > 0 {
+		obj.TemplateName = v
+	}
+
+	if v, ok := in["user"].(string); ok && len(v) > 0 {
+		obj.User = v
+	}
+
+	if v, ok := in["vcpu"].(string); ok && len(v) > 0 {
+		obj.Vcpu = v
+	}
+
+	if v, ok := in["xml_rpc_url"].(string); ok && len(v) > 0 {
+		obj.XMLRPCURL = v
+	}
+
+	return obj
+}
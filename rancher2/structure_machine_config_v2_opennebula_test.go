@@ -0,0 +1,96 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testMachineConfigV2OpennebulaConf      *MachineConfigV2Opennebula
+	testMachineConfigV2OpennebulaInterface []interface{}
+)
+
+func init() {
+	testMachineConfigV2OpennebulaConf = &MachineConfigV2Opennebula{}
+	testMachineConfigV2OpennebulaConf.TypeMeta.Kind = machineConfigV2OpennebulaKind
+	testMachineConfigV2OpennebulaConf.TypeMeta.APIVersion = machineConfigV2OpennebulaAPIVersion
+	testMachineConfigV2OpennebulaConf.B2dSize = "b2d_size"
+	testMachineConfigV2OpennebulaConf.CPU = "1"
+	testMachineConfigV2OpennebulaConf.DevPrefix = "vd"
+	testMachineConfigV2OpennebulaConf.DisableVnc = true
+	testMachineConfigV2OpennebulaConf.DiskResize = "20480"
+	testMachineConfigV2OpennebulaConf.ImageID = "image_id"
+	testMachineConfigV2OpennebulaConf.ImageName = "image_name"
+	testMachineConfigV2OpennebulaConf.ImageOwner = "image_owner"
+	testMachineConfigV2OpennebulaConf.Memory = "2048"
+	testMachineConfigV2OpennebulaConf.NetworkID = "network_id"
+	testMachineConfigV2OpennebulaConf.NetworkName = "network_name"
+	testMachineConfigV2OpennebulaConf.NetworkOwner = "network_owner"
+	testMachineConfigV2OpennebulaConf.Password = "password"
+	testMachineConfigV2OpennebulaConf.SSHUser = "docker"
+	testMachineConfigV2OpennebulaConf.TemplateID = "template_id"
+	testMachineConfigV2OpennebulaConf.TemplateName = "template_name"
+	testMachineConfigV2OpennebulaConf.User = "user"
+	testMachineConfigV2OpennebulaConf.Vcpu = "2"
+	testMachineConfigV2OpennebulaConf.XMLRPCURL = "http://localhost:2633/RPC2"
+	testMachineConfigV2OpennebulaInterface = []interface{}{
+		map[string]interface{}{
+			"b2d_size":      "b2d_size",
+			"cpu":           "1",
+			"dev_prefix":    "vd",
+			"disable_vnc":   true,
+			"disk_resize":   "20480",
+			"image_id":      "image_id",
+			"image_name":    "image_name",
+			"image_owner":   "image_owner",
+			"memory":        "2048",
+			"network_id":    "network_id",
+			"network_name":  "network_name",
+			"network_owner": "network_owner",
+			"password":      "password",
+			"ssh_user":      "docker",
+			"template_id":   "template_id",
+			"template_name": "template_name",
+			"user":          "user",
+			"vcpu":          "2",
+			"xml_rpc_url":   "http://localhost:2633/RPC2",
+		},
+	}
+}
+
+func TestFlattenMachineConfigV2Opennebula(t *testing.T) {
+
+	cases := []struct {
+		Input          *MachineConfigV2Opennebula
+		ExpectedOutput []interface{}
+	}{
+		{
+			testMachineConfigV2OpennebulaConf,
+			testMachineConfigV2OpennebulaInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenMachineConfigV2Opennebula(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandMachineConfigV2Opennebula(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *MachineConfigV2Opennebula
+	}{
+		{
+			testMachineConfigV2OpennebulaInterface,
+			testMachineConfigV2OpennebulaConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandMachineConfigV2Opennebula(tc.Input, &MachineConfigV2{})
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
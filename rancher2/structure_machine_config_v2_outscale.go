@@ -0,0 +1,157 @@
+package rancher2
+
+import (
+	norman "github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	machineConfigV2OutscaleKind         = "OutscaleConfig"
+	machineConfigV2OutscaleAPIVersion   = "rke-machine-config.cattle.io/v1"
+	machineConfigV2OutscaleAPIType      = "rke-machine-config.cattle.io.outscaleconfig"
+	machineConfigV2OutscaleClusterIDsep = "."
+)
+
+//Types
+
+type machineConfigV2Outscale struct {
+	metav1.TypeMeta    `json:",inline"`
+	metav1.ObjectMeta  `json:"metadata,omitempty"`
+	AccessKey          string   `json:"accessKey,omitempty" yaml:"accessKey,omitempty"`
+	ExtraTagsAll       []string `json:"extraTagsAll,omitempty" yaml:"extraTagsAll,omitempty"`
+	ExtraTagsInstances []string `json:"extraTagsInstances,omitempty" yaml:"extraTagsInstances,omitempty"`
+	InstanceType       string   `json:"instanceType,omitempty" yaml:"instanceType,omitempty"`
+	Region             string   `json:"region,omitempty" yaml:"region,omitempty"`
+	RootDiskIops       string   `json:"rootDiskIops,omitempty" yaml:"rootDiskIops,omitempty"`
+	RootDiskSize       string   `json:"rootDiskSize,omitempty" yaml:"rootDiskSize,omitempty"`
+	RootDiskType       string   `json:"rootDiskType,omitempty" yaml:"rootDiskType,omitempty"`
+	SecretKey          string   `json:"secretKey,omitempty" yaml:"secretKey,omitempty"`
+	SecurityGroupIds   []string `json:"securityGroupIds,omitempty" yaml:"securityGroupIds,omitempty"`
+	SourceOmi          string   `json:"sourceOmi,omitempty" yaml:"sourceOmi,omitempty"`
+}
+
+type MachineConfigV2Outscale struct {
+	norman.Resource
+	machineConfigV2Outscale
+}
+
+// Flatteners
+
+func flattenMachineConfigV2Outscale(in *MachineConfigV2Outscale) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	obj := make(map[string]interface{})
+
+	if len(in.AccessKey) > 0 {
+		obj["access_key"] = in.AccessKey
+	}
+
+	if len(in.ExtraTagsAll) > 0 {
+		obj["extra_tags_all"] = toArrayInterface(in.ExtraTagsAll)
+	}
+
+	if len(in.ExtraTagsInstances) > 0 {
+		obj["extra_tags_instances"] = toArrayInterface(in.ExtraTagsInstances)
+	}
+
+	if len(in.InstanceType) > 0 {
+		obj["instance_type"] = in.InstanceType
+	}
+
+	if len(in.Region) > 0 {
+		obj["region"] = in.Region
+	}
+
+	if len(in.RootDiskIops) > 0 {
+		obj["root_disk_iops"] = in.RootDiskIops
+	}
+
+	if len(in.RootDiskSize) > 0 {
+		obj["root_disk_size"] = in.RootDiskSize
+	}
+
+	if len(in.RootDiskType) > 0 {
+		obj["root_disk_type"] = in.RootDiskType
+	}
+
+	if len(in.SecretKey) > 0 {
+		obj["secret_key"] = in.SecretKey
+	}
+
+	if len(in.SecurityGroupIds) > 0 {
+		obj["security_group_ids"] = toArrayInterface(in.SecurityGroupIds)
+	}
+
+	if len(in.SourceOmi) > 0 {
+		obj["source_omi"] = in.SourceOmi
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandMachineConfigV2Outscale(p []interface{}, source *MachineConfigV2) *MachineConfigV2Outscale {
+	if p == nil || len(p) == 0 || p[0] == nil {
+		return nil
+	}
+	obj := &MachineConfigV2Outscale{}
+
+	if len(source.ID) > 0 {
+		obj.ID = source.ID
+	}
+	in := p[0].(map[string]interface{})
+
+	obj.TypeMeta.Kind = machineConfigV2OutscaleKind
+	obj.TypeMeta.APIVersion = machineConfigV2OutscaleAPIVersion
+	source.TypeMeta = obj.TypeMeta
+	obj.ObjectMeta = source.ObjectMeta
+
+	if v, ok := in["access_key"].(string); ok && len(v) > 0 {
+		obj.AccessKey = v
+	}
+
+	if v, ok := in["extra_tags_all"].([]interface{}); ok && len(v) > 0 {
+		obj.ExtraTagsAll = toArrayString(v)
+	}
+
+	if v, ok := in["extra_tags_instances"].([]interface{}); ok && len(v) > 0 {
+		obj.ExtraTagsInstances = toArrayString(v)
+	}
+
+	if v, ok := in["instance_type"].(string); ok && len(v) > 0 {
+		obj.InstanceType = v
+	}
+
+	if v, ok := in["region"].(string); ok && len(v) > 0 {
+		obj.Region = v
+	}
+
+	if v, ok := in["root_disk_iops"].(string); ok && len(v) > 0 {
+		obj.RootDiskIops = v
+	}
+
+	if v, ok := in["root_disk_size"].(string); ok && len(v) > 0 {
+		obj.RootDiskSize = v
+	}
+
+	if v, ok := in["root_disk_type"].(string); ok && len(v) > 0 {
+		obj.RootDiskType = v
+	}
+
+	if v, ok := in["secret_key"].(string); ok && len(v) > 0 {
+		obj.SecretKey = v
+	}
+
+	if v, ok := in["security_group_ids"].([]interface{}); ok && len(v) > 0 {
+		obj.SecurityGroupIds = toArrayString(v)
+	}
+
+	if v, ok := in["source_omi"].(string); ok && len(v) > 0 {
+		obj.SourceOmi = v
+	}
+
+	return obj
+}
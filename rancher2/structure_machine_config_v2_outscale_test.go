@@ -0,0 +1,80 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testMachineConfigV2OutscaleConf      *MachineConfigV2Outscale
+	testMachineConfigV2OutscaleInterface []interface{}
+)
+
+func init() {
+	testMachineConfigV2OutscaleConf = &MachineConfigV2Outscale{}
+	testMachineConfigV2OutscaleConf.TypeMeta.Kind = machineConfigV2OutscaleKind
+	testMachineConfigV2OutscaleConf.TypeMeta.APIVersion = machineConfigV2OutscaleAPIVersion
+	testMachineConfigV2OutscaleConf.AccessKey = "access_key"
+	testMachineConfigV2OutscaleConf.ExtraTagsAll = []string{"env=prod"}
+	testMachineConfigV2OutscaleConf.ExtraTagsInstances = []string{"role=worker", "team=foo"}
+	testMachineConfigV2OutscaleConf.InstanceType = "tinav2.c1r2p3"
+	testMachineConfigV2OutscaleConf.Region = "eu-west-2"
+	testMachineConfigV2OutscaleConf.RootDiskIops = "1500"
+	testMachineConfigV2OutscaleConf.RootDiskSize = "15"
+	testMachineConfigV2OutscaleConf.RootDiskType = "io1"
+	testMachineConfigV2OutscaleConf.SecretKey = "secret_key"
+	testMachineConfigV2OutscaleConf.SecurityGroupIds = []string{"sg-1", "sg-2"}
+	testMachineConfigV2OutscaleConf.SourceOmi = "ami-12345678"
+	testMachineConfigV2OutscaleInterface = []interface{}{
+		map[string]interface{}{
+			"access_key":           "access_key",
+			"extra_tags_all":       []interface{}{"env=prod"},
+			"extra_tags_instances": []interface{}{"role=worker", "team=foo"},
+			"instance_type":        "tinav2.c1r2p3",
+			"region":               "eu-west-2",
+			"root_disk_iops":       "1500",
+			"root_disk_size":       "15",
+			"root_disk_type":       "io1",
+			"secret_key":           "secret_key",
+			"security_group_ids":   []interface{}{"sg-1", "sg-2"},
+			"source_omi":           "ami-12345678",
+		},
+	}
+}
+
+func TestFlattenMachineConfigV2Outscale(t *testing.T) {
+
+	cases := []struct {
+		Input          *MachineConfigV2Outscale
+		ExpectedOutput []interface{}
+	}{
+		{
+			testMachineConfigV2OutscaleConf,
+			testMachineConfigV2OutscaleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenMachineConfigV2Outscale(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener.")
+	}
+}
+
+func TestExpandMachineConfigV2Outscale(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *MachineConfigV2Outscale
+	}{
+		{
+			testMachineConfigV2OutscaleInterface,
+			testMachineConfigV2OutscaleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandMachineConfigV2Outscale(tc.Input, &MachineConfigV2{})
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
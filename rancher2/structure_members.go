@@ -0,0 +1,195 @@
+package rancher2
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+// Flatteners
+
+func flattenClusterRoleTemplateBindingMember(in managementClient.ClusterRoleTemplateBinding) roleTemplateBindingMember {
+	return roleTemplateBindingMember{
+		BindingID:        in.ID,
+		RoleTemplateID:   in.RoleTemplateID,
+		UserID:           in.UserID,
+		UserPrincipalID:  in.UserPrincipalID,
+		GroupID:          in.GroupID,
+		GroupPrincipalID: in.GroupPrincipalID,
+	}
+}
+
+func flattenProjectRoleTemplateBindingMember(in managementClient.ProjectRoleTemplateBinding) roleTemplateBindingMember {
+	return roleTemplateBindingMember{
+		BindingID:        in.ID,
+		RoleTemplateID:   in.RoleTemplateID,
+		UserID:           in.UserID,
+		UserPrincipalID:  in.UserPrincipalID,
+		GroupID:          in.GroupID,
+		GroupPrincipalID: in.GroupPrincipalID,
+	}
+}
+
+// flattenRoleTemplateBindingMembers flattens remote members. Remote members matching a config member are
+// flattened as defined at config, to avoid diffs on user and principal ID fields filled by Rancher
+func flattenRoleTemplateBindingMembers(remote, config []roleTemplateBindingMember) []interface{} {
+	out := make([]interface{}, 0, len(remote))
+	for _, in := range remote {
+		member := in
+		found := false
+		for _, conf := range config {
+			if isRoleTemplateBindingMemberEqual(in, conf) {
+				member = conf
+				found = true
+				break
+			}
+		}
+		if !found {
+			// Using just one user and group ID for unmanaged members
+			if len(member.UserID) > 0 {
+				member.UserPrincipalID = ""
+			}
+			if len(member.GroupPrincipalID) > 0 {
+				member.GroupID = ""
+			}
+		}
+		out = append(out, map[string]interface{}{
+			"role_template_id":   member.RoleTemplateID,
+			"group_id":           member.GroupID,
+			"group_principal_id": member.GroupPrincipalID,
+			"user_id":            member.UserID,
+			"user_principal_id":  member.UserPrincipalID,
+		})
+	}
+
+	return out
+}
+
+// Expanders
+
+func expandRoleTemplateBindingMembers(p []interface{}) ([]roleTemplateBindingMember, error) {
+	out := make([]roleTemplateBindingMember, 0, len(p))
+	for _, v := range p {
+		in, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		obj := roleTemplateBindingMember{}
+		if v, ok := in["role_template_id"].(string); ok {
+			obj.RoleTemplateID = v
+		}
+		if v, ok := in["group_id"].(string); ok {
+			obj.GroupID = v
+		}
+		if v, ok := in["group_principal_id"].(string); ok {
+			obj.GroupPrincipalID = v
+		}
+		if v, ok := in["user_id"].(string); ok {
+			obj.UserID = v
+		}
+		if v, ok := in["user_principal_id"].(string); ok {
+			obj.UserPrincipalID = v
+		}
+		if len(obj.GroupID) == 0 && len(obj.GroupPrincipalID) == 0 && len(obj.UserID) == 0 && len(obj.UserPrincipalID) == 0 {
+			return nil, fmt.Errorf("Expanding members: member with role template %s has no user or group defined", obj.RoleTemplateID)
+		}
+		out = append(out, obj)
+	}
+
+	return out, nil
+}
+
+func expandRoleTemplateBindingMembersExclusion(in *schema.ResourceData) *roleTemplateBindingMembersExclusion {
+	obj := &roleTemplateBindingMembersExclusion{}
+	if in == nil {
+		return obj
+	}
+
+	obj.Owner = in.Get("exclude_owner").(bool)
+	if v, ok := in.Get("excluded_principal_ids").([]interface{}); ok && len(v) > 0 {
+		obj.PrincipalIDs = toArrayString(v)
+	}
+	if v, ok := in.Get("excluded_role_template_ids").([]interface{}); ok && len(v) > 0 {
+		obj.RoleTemplateIDs = toArrayString(v)
+	}
+
+	return obj
+}
+
+// isRoleTemplateBindingMemberEqual returns true if both members bind the same role template to the same
+// user or group. Rancher fills user and principal IDs, so any identity field in common is enough
+func isRoleTemplateBindingMemberEqual(a, b roleTemplateBindingMember) bool {
+	if a.RoleTemplateID != b.RoleTemplateID {
+		return false
+	}
+	sameField := func(x, y string) bool {
+		return len(x) > 0 && x == y
+	}
+
+	return sameField(a.UserID, b.UserID) || sameField(a.UserPrincipalID, b.UserPrincipalID) ||
+		sameField(a.GroupID, b.GroupID) || sameField(a.GroupPrincipalID, b.GroupPrincipalID)
+}
+
+// filterRoleTemplateBindingMembers returns members not excluded from management. Members defined at config are never excluded
+func filterRoleTemplateBindingMembers(in, config []roleTemplateBindingMember, exclusion *roleTemplateBindingMembersExclusion, ownerRoleTemplateID string) []roleTemplateBindingMember {
+	if exclusion == nil {
+		return in
+	}
+
+	out := make([]roleTemplateBindingMember, 0, len(in))
+	for _, member := range in {
+		if isRoleTemplateBindingMemberIn(member, config) {
+			out = append(out, member)
+			continue
+		}
+		if exclusion.Owner && member.RoleTemplateID == ownerRoleTemplateID {
+			continue
+		}
+		if slices.Contains(exclusion.RoleTemplateIDs, member.RoleTemplateID) {
+			continue
+		}
+		excluded := false
+		for _, id := range []string{member.UserID, member.UserPrincipalID, member.GroupID, member.GroupPrincipalID} {
+			if len(id) > 0 && slices.Contains(exclusion.PrincipalIDs, id) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			out = append(out, member)
+		}
+	}
+
+	return out
+}
+
+// diffRoleTemplateBindingMembers returns config members to be added and remote members to be removed
+func diffRoleTemplateBindingMembers(remote, config []roleTemplateBindingMember) ([]roleTemplateBindingMember, []roleTemplateBindingMember) {
+	add := []roleTemplateBindingMember{}
+	for _, conf := range config {
+		if !isRoleTemplateBindingMemberIn(conf, remote) {
+			add = append(add, conf)
+		}
+	}
+
+	remove := []roleTemplateBindingMember{}
+	for _, member := range remote {
+		if !isRoleTemplateBindingMemberIn(member, config) {
+			remove = append(remove, member)
+		}
+	}
+
+	return add, remove
+}
+
+func isRoleTemplateBindingMemberIn(member roleTemplateBindingMember, list []roleTemplateBindingMember) bool {
+	for _, item := range list {
+		if isRoleTemplateBindingMemberEqual(member, item) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testMembersRemoteConf []roleTemplateBindingMember
+	testMembersConfigConf []roleTemplateBindingMember
+)
+
+func init() {
+	testMembersRemoteConf = []roleTemplateBindingMember{
+		{
+			BindingID:       "p-abcde:creator-project-owner",
+			RoleTemplateID:  "project-owner",
+			UserID:          "u-admin",
+			UserPrincipalID: "local://u-admin",
+		},
+		{
+			BindingID:       "p-abcde:prtb-1",
+			RoleTemplateID:  "project-member",
+			UserID:          "u-foo",
+			UserPrincipalID: "local://u-foo",
+		},
+		{
+			BindingID:        "p-abcde:prtb-2",
+			RoleTemplateID:   "read-only",
+			GroupPrincipalID: "github_team://1234",
+		},
+		{
+			BindingID:       "p-abcde:prtb-3",
+			RoleTemplateID:  "project-member",
+			UserID:          "u-bar",
+			UserPrincipalID: "local://u-bar",
+		},
+	}
+	testMembersConfigConf = []roleTemplateBindingMember{
+		{
+			RoleTemplateID: "project-member",
+			UserID:         "u-foo",
+		},
+		{
+			RoleTemplateID:   "read-only",
+			GroupPrincipalID: "github_team://1234",
+		},
+		{
+			RoleTemplateID:  "project-member",
+			UserPrincipalID: "local://u-new",
+		},
+	}
+}
+
+func TestFlattenRoleTemplateBindingMembers(t *testing.T) {
+	expected := []interface{}{
+		map[string]interface{}{
+			"role_template_id":   "project-member",
+			"group_id":           "",
+			"group_principal_id": "",
+			"user_id":            "u-foo",
+			"user_principal_id":  "",
+		},
+		map[string]interface{}{
+			"role_template_id":   "read-only",
+			"group_id":           "",
+			"group_principal_id": "github_team://1234",
+			"user_id":            "",
+			"user_principal_id":  "",
+		},
+		map[string]interface{}{
+			"role_template_id":   "project-member",
+			"group_id":           "",
+			"group_principal_id": "",
+			"user_id":            "u-bar",
+			"user_principal_id":  "",
+		},
+	}
+
+	output := flattenRoleTemplateBindingMembers(testMembersRemoteConf[1:], testMembersConfigConf)
+	assert.Equal(t, expected, output, "Unexpected output from flattener.")
+}
+
+func TestExpandRoleTemplateBindingMembers(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"role_template_id": "project-member",
+			"user_id":          "u-foo",
+		},
+	}
+	output, err := expandRoleTemplateBindingMembers(input)
+	assert.NoError(t, err)
+	assert.Equal(t, testMembersConfigConf[:1], output, "Unexpected output from expander.")
+
+	_, err = expandRoleTemplateBindingMembers([]interface{}{map[string]interface{}{"role_template_id": "project-member"}})
+	assert.Error(t, err)
+}
+
+func TestFilterRoleTemplateBindingMembers(t *testing.T) {
+
+	cases := []struct {
+		Exclusion      *roleTemplateBindingMembersExclusion
+		Config         []roleTemplateBindingMember
+		ExpectedOutput []roleTemplateBindingMember
+	}{
+		{
+			nil,
+			nil,
+			testMembersRemoteConf,
+		},
+		{
+			&roleTemplateBindingMembersExclusion{Owner: true},
+			nil,
+			testMembersRemoteConf[1:],
+		},
+		{
+			&roleTemplateBindingMembersExclusion{Owner: true},
+			[]roleTemplateBindingMember{{RoleTemplateID: "project-owner", UserID: "u-admin"}},
+			testMembersRemoteConf,
+		},
+		{
+			&roleTemplateBindingMembersExclusion{
+				Owner:           true,
+				PrincipalIDs:    []string{"local://u-bar"},
+				RoleTemplateIDs: []string{"read-only"},
+			},
+			nil,
+			testMembersRemoteConf[1:2],
+		},
+	}
+
+	for _, tc := range cases {
+		output := filterRoleTemplateBindingMembers(testMembersRemoteConf, tc.Config, tc.Exclusion, membersProjectOwnerRoleTemplateID)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from filter.")
+	}
+}
+
+func TestDiffRoleTemplateBindingMembers(t *testing.T) {
+	add, remove := diffRoleTemplateBindingMembers(testMembersRemoteConf[1:], testMembersConfigConf)
+	assert.Equal(t, testMembersConfigConf[2:], add, "Unexpected members to add.")
+	assert.Equal(t, testMembersRemoteConf[3:], remove, "Unexpected members to remove.")
+}
@@ -0,0 +1,119 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+// Flatteners
+
+func flattenNode(d *schema.ResourceData, in *managementClient.Node) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("cluster_id", in.ClusterID)
+	d.Set("node_name", in.NodeName)
+	d.Set("hostname", in.Hostname)
+	d.Set("state", in.State)
+	d.Set("cordoned", in.Unschedulable)
+	d.Set("drained", in.State == nodeStateDrained)
+
+	err := d.Set("annotations", flattenNodeOwnedMap(in.Annotations, d.Get("annotations").(map[string]interface{})))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", flattenNodeOwnedMap(in.Labels, d.Get("labels").(map[string]interface{})))
+	if err != nil {
+		return err
+	}
+
+	return d.Set("taints", flattenNodeOwnedTaints(in.Taints, d.Get("taints").([]interface{})))
+}
+
+// flattenNodeOwnedMap returns the node values of the owned keys. Keys removed from the node are omitted
+func flattenNodeOwnedMap(in map[string]string, owned map[string]interface{}) map[string]interface{} {
+	obj := make(map[string]interface{}, len(owned))
+	for k := range owned {
+		if v, ok := in[k]; ok {
+			obj[k] = v
+		}
+	}
+
+	return obj
+}
+
+// flattenNodeOwnedTaints returns the node taints matching owned taints key and effect, in the owned taints order
+func flattenNodeOwnedTaints(in []managementClient.Taint, owned []interface{}) []interface{} {
+	out := []managementClient.Taint{}
+	for _, o := range expandTaints(owned) {
+		for _, taint := range in {
+			if isNodeTaintEqual(taint, o) {
+				out = append(out, taint)
+				break
+			}
+		}
+	}
+
+	return flattenTaints(out)
+}
+
+// Expanders
+
+// expandNodeDrainInput returns the node drain input, using the drain_input defaults if not set
+func expandNodeDrainInput(p []interface{}) *managementClient.NodeDrainInput {
+	if len(p) == 0 || p[0] == nil {
+		p = []interface{}{
+			map[string]interface{}{
+				"grace_period":       -1,
+				"ignore_daemon_sets": true,
+				"timeout":            60,
+			},
+		}
+	}
+
+	return expandClusterRKEConfigNodeDrainInput(p)
+}
+
+// expandNodeOwnedMap replaces the old owned keys by the new ones on the node map, keeping not owned keys
+func expandNodeOwnedMap(in map[string]string, old, owned map[string]interface{}) map[string]string {
+	obj := make(map[string]string, len(in)+len(owned))
+	for k, v := range in {
+		if _, ok := old[k]; ok {
+			continue
+		}
+		obj[k] = v
+	}
+	for k, v := range toMapString(owned) {
+		obj[k] = v
+	}
+
+	return obj
+}
+
+// expandNodeOwnedTaints replaces the old owned taints by the new ones on the node taints, keeping not owned taints
+func expandNodeOwnedTaints(in []managementClient.Taint, old, owned []interface{}) []managementClient.Taint {
+	oldTaints := expandTaints(old)
+	newTaints := expandTaints(owned)
+	obj := make([]managementClient.Taint, 0, len(in)+len(newTaints))
+	for _, taint := range in {
+		isOwned := false
+		for _, o := range append(oldTaints, newTaints...) {
+			if isNodeTaintEqual(taint, o) {
+				isOwned = true
+				break
+			}
+		}
+		if !isOwned {
+			obj = append(obj, taint)
+		}
+	}
+
+	return append(obj, newTaints...)
+}
+
+func isNodeTaintEqual(a, b managementClient.Taint) bool {
+	return a.Key == b.Key && a.Effect == b.Effect
+}
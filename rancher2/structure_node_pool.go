@@ -80,3 +80,65 @@ func expandNodePool(in *schema.ResourceData) *managementClient.NodePool {
 
 	return obj
 }
+
+func expandNodePoolRollingReplace(p []interface{}) *nodePoolRollingReplace {
+	if len(p) == 0 || p[0] == nil {
+		return nil
+	}
+	in := p[0].(map[string]interface{})
+
+	obj := &nodePoolRollingReplace{}
+
+	if v, ok := in["drain"].(bool); ok {
+		obj.Drain = v
+	}
+
+	if v, ok := in["drain_input"].([]interface{}); ok {
+		obj.DrainInput = expandNodeDrainInput(v)
+	}
+
+	if v, ok := in["max_surge"].(int); ok {
+		obj.MaxSurge = v
+	}
+
+	if v, ok := in["max_unavailable"].(int); ok {
+		obj.MaxUnavailable = v
+	}
+
+	return obj
+}
+
+// splitNodePoolNodes returns the pool nodes not using nodeTemplateID and the number of active nodes using it.
+// Nodes already being removed are ignored
+func splitNodePoolNodes(nodes []managementClient.Node, nodeTemplateID string) ([]managementClient.Node, int) {
+	old := []managementClient.Node{}
+	active := 0
+	for _, node := range nodes {
+		if node.State == nodeStateRemoving || node.State == nodeStateRemoved {
+			continue
+		}
+		if node.NodeTemplateID != nodeTemplateID {
+			old = append(old, node)
+			continue
+		}
+		if node.State == nodeStateActive {
+			active++
+		}
+	}
+
+	return old, active
+}
+
+// batchSize returns the number of old nodes that can be replaced for a pool of quantity nodes, with active
+// updated nodes and old nodes remaining. It's 0 while previous replacements are not active yet
+func (r *nodePoolRollingReplace) batchSize(quantity, active, old int) int {
+	if old == 0 || active < quantity+r.MaxSurge-old {
+		return 0
+	}
+	size := r.MaxSurge + r.MaxUnavailable
+	if size > old {
+		size = old
+	}
+
+	return size
+}
@@ -120,3 +120,85 @@ func TestExpandNodePool(t *testing.T) {
 		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
 	}
 }
+
+func TestExpandNodePoolRollingReplace(t *testing.T) {
+	ignoreDaemonSets := true
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *nodePoolRollingReplace
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"drain":           true,
+					"drain_input":     []interface{}{},
+					"max_surge":       2,
+					"max_unavailable": 1,
+				},
+			},
+			&nodePoolRollingReplace{
+				Drain: true,
+				DrainInput: &managementClient.NodeDrainInput{
+					GracePeriod:      -1,
+					IgnoreDaemonSets: &ignoreDaemonSets,
+					Timeout:          60,
+				},
+				MaxSurge:       2,
+				MaxUnavailable: 1,
+			},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNodePoolRollingReplace(tc.Input)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
+	}
+}
+
+func TestSplitNodePoolNodes(t *testing.T) {
+	nodes := []managementClient.Node{
+		{NodeTemplateID: "nt-old", State: nodeStateActive},
+		{NodeTemplateID: "nt-old", State: nodeStateRemoving},
+		{NodeTemplateID: "nt-new", State: nodeStateActive},
+		{NodeTemplateID: "nt-new", State: "provisioning"},
+	}
+
+	old, active := splitNodePoolNodes(nodes, "nt-new")
+	assert.Equal(t, []managementClient.Node{nodes[0]}, old)
+	assert.Equal(t, 1, active)
+}
+
+func TestNodePoolRollingReplaceBatchSize(t *testing.T) {
+
+	cases := []struct {
+		MaxSurge       int
+		MaxUnavailable int
+		Quantity       int
+		Active         int
+		Old            int
+		ExpectedOutput int
+	}{
+		// Waiting for the surge node
+		{1, 0, 3, 0, 3, 0},
+		{1, 0, 3, 1, 3, 1},
+		// Waiting for the replacement node
+		{1, 0, 3, 1, 2, 0},
+		{1, 0, 3, 3, 1, 1},
+		{0, 1, 3, 0, 3, 1},
+		{0, 1, 3, 0, 2, 0},
+		{2, 1, 3, 2, 3, 3},
+		{2, 2, 3, 2, 3, 3},
+		{1, 0, 3, 4, 0, 0},
+	}
+
+	for _, tc := range cases {
+		rollingReplace := &nodePoolRollingReplace{MaxSurge: tc.MaxSurge, MaxUnavailable: tc.MaxUnavailable}
+		output := rollingReplace.batchSize(tc.Quantity, tc.Active, tc.Old)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected batch size for %#v.", tc)
+	}
+}
@@ -59,7 +59,14 @@ func flattenNodeTemplate(d *schema.ResourceData, in *NodeTemplate) error {
 			return fmt.Errorf("[ERROR] Node template driver %s requires outscale_config", in.Driver)
 		}
 	default:
-		return fmt.Errorf("[ERROR] Unsupported driver on node template: %s", in.Driver)
+		if in.DriverConfig == nil {
+			return fmt.Errorf("[ERROR] Unsupported driver on node template: %s", in.Driver)
+		}
+		v, _ := d.Get("driver_config").([]interface{})
+		err := d.Set("driver_config", flattenDriverConfig(in.Driver, in.DriverConfig, v))
+		if err != nil {
+			return err
+		}
 	}
 
 	if len(in.AuthCertificateAuthority) > 0 {
@@ -185,6 +192,12 @@ func expandNodeTemplate(in *schema.ResourceData) *NodeTemplate {
 		obj.Driver = digitaloceanConfigDriver
 	}
 
+	if v, ok := in.Get("driver_config").([]interface{}); ok && len(v) > 0 {
+		driver, fields, sensitiveFields := expandDriverConfig(v)
+		obj.DriverConfig = mergeDriverConfigFields(fields, sensitiveFields)
+		obj.Driver = driver
+	}
+
 	if v, ok := in.Get("engine_env").(map[string]interface{}); ok && len(v) > 0 {
 		obj.EngineEnv = toMapString(v)
 	}
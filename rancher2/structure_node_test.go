@@ -0,0 +1,140 @@
+package rancher2
+
+import (
+	"testing"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testNodeTaints []managementClient.Taint
+)
+
+func init() {
+	testNodeTaints = []managementClient.Taint{
+		{
+			Key:    "node.kubernetes.io/unschedulable",
+			Effect: "NoSchedule",
+		},
+		{
+			Key:    "key",
+			Value:  "old",
+			Effect: "NoSchedule",
+		},
+		{
+			Key:    "removed",
+			Value:  "value",
+			Effect: "NoExecute",
+		},
+	}
+}
+
+func TestFlattenNodeOwnedMap(t *testing.T) {
+	in := map[string]string{
+		"cattle.io/creator": "norman",
+		"foo":               "bar",
+	}
+	owned := map[string]interface{}{
+		"foo":     "old",
+		"missing": "value",
+	}
+	expected := map[string]interface{}{
+		"foo": "bar",
+	}
+
+	output := flattenNodeOwnedMap(in, owned)
+	assert.Equal(t, expected, output, "Unexpected output from flattener.")
+}
+
+func TestFlattenNodeOwnedTaints(t *testing.T) {
+	owned := []interface{}{
+		map[string]interface{}{
+			"key":    "key",
+			"value":  "new",
+			"effect": "NoSchedule",
+		},
+		map[string]interface{}{
+			"key":    "key",
+			"value":  "new",
+			"effect": "NoExecute",
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"key":    "key",
+			"value":  "old",
+			"effect": "NoSchedule",
+		},
+	}
+
+	output := flattenNodeOwnedTaints(testNodeTaints, owned)
+	assert.Equal(t, expected, output, "Unexpected output from flattener.")
+}
+
+func TestExpandNodeOwnedMap(t *testing.T) {
+	in := map[string]string{
+		"cattle.io/creator": "norman",
+		"foo":               "bar",
+		"removed":           "value",
+	}
+	old := map[string]interface{}{
+		"foo":     "bar",
+		"removed": "value",
+	}
+	owned := map[string]interface{}{
+		"foo": "new",
+		"new": "value",
+	}
+	expected := map[string]string{
+		"cattle.io/creator": "norman",
+		"foo":               "new",
+		"new":               "value",
+	}
+
+	output := expandNodeOwnedMap(in, old, owned)
+	assert.Equal(t, expected, output, "Unexpected output from expander.")
+}
+
+func TestExpandNodeOwnedTaints(t *testing.T) {
+	old := []interface{}{
+		map[string]interface{}{
+			"key":    "removed",
+			"value":  "value",
+			"effect": "NoExecute",
+		},
+	}
+	owned := []interface{}{
+		map[string]interface{}{
+			"key":    "key",
+			"value":  "new",
+			"effect": "NoSchedule",
+		},
+	}
+	expected := []managementClient.Taint{
+		{
+			Key:    "node.kubernetes.io/unschedulable",
+			Effect: "NoSchedule",
+		},
+		{
+			Key:    "key",
+			Value:  "new",
+			Effect: "NoSchedule",
+		},
+	}
+
+	output := expandNodeOwnedTaints(testNodeTaints, old, owned)
+	assert.Equal(t, expected, output, "Unexpected output from expander.")
+}
+
+func TestExpandNodeDrainInput(t *testing.T) {
+	ignoreDaemonSets := true
+	expected := &managementClient.NodeDrainInput{
+		GracePeriod:      -1,
+		IgnoreDaemonSets: &ignoreDaemonSets,
+		Timeout:          60,
+	}
+
+	output := expandNodeDrainInput([]interface{}{})
+	assert.Equal(t, expected, output, "Unexpected output from expander.")
+}
@@ -0,0 +1,50 @@
+package rancher2
+
+import (
+	"strings"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+// Flatteners
+
+func flattenPrincipals(in []managementClient.Principal) ([]interface{}, []interface{}) {
+	principals := make([]interface{}, 0, len(in))
+	ids := make([]interface{}, 0, len(in))
+	for _, principal := range in {
+		principals = append(principals, map[string]interface{}{
+			"id":         principal.ID,
+			"name":       principal.Name,
+			"login_name": principal.LoginName,
+			"provider":   principal.Provider,
+			"type":       principal.PrincipalType,
+		})
+		ids = append(ids, principal.ID)
+	}
+
+	return principals, ids
+}
+
+// filterPrincipals returns up to limit principals whose name or login name start with prefix, case insensitive,
+// matching provider and type if not empty
+func filterPrincipals(in []managementClient.Principal, prefix, principalType, provider string, limit int) []managementClient.Principal {
+	prefix = strings.ToLower(prefix)
+	out := []managementClient.Principal{}
+	for _, principal := range in {
+		if limit > 0 && len(out) >= limit {
+			break
+		}
+		if len(principalType) > 0 && !strings.EqualFold(principal.PrincipalType, principalType) {
+			continue
+		}
+		if len(provider) > 0 && !strings.EqualFold(principal.Provider, provider) {
+			continue
+		}
+		if !strings.HasPrefix(strings.ToLower(principal.Name), prefix) && !strings.HasPrefix(strings.ToLower(principal.LoginName), prefix) {
+			continue
+		}
+		out = append(out, principal)
+	}
+
+	return out
+}
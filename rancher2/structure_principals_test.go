@@ -0,0 +1,78 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/rancher/norman/types"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testPrincipalsConf []managementClient.Principal
+)
+
+func init() {
+	testPrincipalsConf = []managementClient.Principal{
+		{
+			Resource:      types.Resource{ID: "activedirectory_group://CN=team-foo,OU=groups,DC=example,DC=com"},
+			Name:          "team-foo",
+			PrincipalType: principalTypeGroup,
+			Provider:      "activedirectory",
+		},
+		{
+			Resource:      types.Resource{ID: "activedirectory_user://CN=Team Bot,OU=users,DC=example,DC=com"},
+			Name:          "Team Bot",
+			LoginName:     "team-bot",
+			PrincipalType: principalTypeUser,
+			Provider:      "activedirectory",
+		},
+		{
+			Resource:      types.Resource{ID: "azuread_group://1234"},
+			Name:          "Team-Bar",
+			PrincipalType: principalTypeGroup,
+			Provider:      "azuread",
+		},
+		{
+			Resource:      types.Resource{ID: "activedirectory_group://CN=my-team,OU=groups,DC=example,DC=com"},
+			Name:          "my-team",
+			PrincipalType: principalTypeGroup,
+			Provider:      "activedirectory",
+		},
+	}
+}
+
+func TestFilterPrincipals(t *testing.T) {
+
+	cases := []struct {
+		Type           string
+		Provider       string
+		Limit          int
+		ExpectedOutput []managementClient.Principal
+	}{
+		{"", "", 0, testPrincipalsConf[:3]},
+		{principalTypeGroup, "", 0, []managementClient.Principal{testPrincipalsConf[0], testPrincipalsConf[2]}},
+		{principalTypeGroup, "activedirectory", 0, testPrincipalsConf[:1]},
+		{"", "", 2, testPrincipalsConf[:2]},
+	}
+
+	for _, tc := range cases {
+		output := filterPrincipals(testPrincipalsConf, "team-", tc.Type, tc.Provider, tc.Limit)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from filter.")
+	}
+}
+
+func TestFlattenPrincipals(t *testing.T) {
+	principals, ids := flattenPrincipals(testPrincipalsConf[1:2])
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":         "activedirectory_user://CN=Team Bot,OU=users,DC=example,DC=com",
+			"name":       "Team Bot",
+			"login_name": "team-bot",
+			"provider":   "activedirectory",
+			"type":       principalTypeUser,
+		},
+	}
+	assert.Equal(t, expected, principals, "Unexpected output from flattener.")
+	assert.Equal(t, []interface{}{"activedirectory_user://CN=Team Bot,OU=users,DC=example,DC=com"}, ids, "Unexpected output from flattener.")
+}
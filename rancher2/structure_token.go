@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
@@ -31,6 +32,7 @@ func flattenToken(d *schema.ResourceData, in *managementClient.Token, patch bool
 	}
 
 	d.Set("expired", in.Expired)
+	d.Set("expires_at", in.ExpiresAt)
 
 	if len(in.Name) > 0 {
 		d.Set("name", in.Name)
@@ -88,13 +90,7 @@ func expandToken(in *schema.ResourceData, patch bool) (*managementClient.Token,
 	}
 
 	if v, ok := in.Get("ttl").(int); ok && v > 0 {
-		if patch {
-			// Rancher v2.4.6 ttl is read in minutes from API
-			mins := math.Round(float64(v / 60))
-			obj.TTLMillis = int64(mins)
-		} else {
-			obj.TTLMillis = int64(v * 1000)
-		}
+		obj.TTLMillis = expandTokenTTL(v, patch)
 	}
 
 	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
@@ -107,3 +103,44 @@ func expandToken(in *schema.ResourceData, patch bool) (*managementClient.Token,
 
 	return obj, nil
 }
+
+// expandTokenTTL returns the token ttl to send to the API from ttl in seconds
+func expandTokenTTL(ttl int, patch bool) int64 {
+	if patch {
+		// Rancher v2.4.6 ttl is read in minutes from API
+		return int64(math.Round(float64(ttl / 60)))
+	}
+	return int64(ttl * 1000)
+}
+
+// isTokenExpiring returns true if the token expiresAt date, RFC3339 format, is before now + window.
+// Tokens without expiration date never expire
+func isTokenExpiring(expiresAt string, window time.Duration, now time.Time) (bool, error) {
+	if len(expiresAt) == 0 {
+		return false, nil
+	}
+	expiration, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, fmt.Errorf("parsing token expiration date %q: %v", expiresAt, err)
+	}
+	return expiration.Before(now.Add(window)), nil
+}
+
+// isTokenRenewRequired returns true if the token is disabled, expired or expires within rotateBefore duration
+func isTokenRenewRequired(in *managementClient.Token, rotateBefore string, now time.Time) (bool, error) {
+	if in == nil {
+		return false, nil
+	}
+	if (in.Enabled != nil && !*in.Enabled) || in.Expired {
+		return true, nil
+	}
+	window := time.Duration(0)
+	if len(rotateBefore) > 0 {
+		var err error
+		window, err = time.ParseDuration(rotateBefore)
+		if err != nil {
+			return false, fmt.Errorf("parsing token rotate_before %q: %v", rotateBefore, err)
+		}
+	}
+	return isTokenExpiring(in.ExpiresAt, window, now)
+}
@@ -2,6 +2,7 @@ package rancher2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
@@ -73,3 +74,56 @@ func TestExpandToken(t *testing.T) {
 		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
 	}
 }
+
+func TestIsTokenExpiring(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		ExpiresAt      string
+		Window         time.Duration
+		ExpectedOutput bool
+		ExpectedErr    bool
+	}{
+		{"", 24 * time.Hour, false, false},
+		{"2024-01-10T00:00:00Z", 0, false, false},
+		{"2024-01-10T00:00:00Z", 24 * time.Hour, false, false},
+		{"2024-01-10T00:00:00Z", 10 * 24 * time.Hour, true, false},
+		{"2023-12-31T00:00:00Z", 0, true, false},
+		{"unknown", 0, false, true},
+	}
+
+	for _, tc := range cases {
+		output, err := isTokenExpiring(tc.ExpiresAt, tc.Window, now)
+		if tc.ExpectedErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected token expiring for %s.", tc.ExpiresAt)
+	}
+}
+
+func TestIsTokenRenewRequired(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	enabled := true
+	disabled := false
+
+	cases := []struct {
+		Input          *managementClient.Token
+		RotateBefore   string
+		ExpectedOutput bool
+	}{
+		{&managementClient.Token{Enabled: &enabled}, "24h", false},
+		{&managementClient.Token{Enabled: &disabled}, "", true},
+		{&managementClient.Token{Enabled: &enabled, Expired: true}, "", true},
+		{&managementClient.Token{Enabled: &enabled, ExpiresAt: "2024-01-10T00:00:00Z"}, "", false},
+		{&managementClient.Token{Enabled: &enabled, ExpiresAt: "2024-01-10T00:00:00Z"}, "24h", false},
+		{&managementClient.Token{Enabled: &enabled, ExpiresAt: "2024-01-10T00:00:00Z"}, "240h", true},
+	}
+
+	for _, tc := range cases {
+		output, err := isTokenRenewRequired(tc.Input, tc.RotateBefore, now)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected token renew required for %#v.", tc.Input)
+	}
+}
@@ -1,6 +1,9 @@
 package rancher2
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 )
@@ -26,6 +29,8 @@ func flattenUser(d *schema.ResourceData, in *managementClient.User) error {
 		return err
 	}
 
+	d.Set("principal_id", flattenUserPrincipalID(in, d.Get("principal_id").(string)))
+
 	err = d.Set("annotations", toMapInterface(in.Annotations))
 	if err != nil {
 		return err
@@ -40,6 +45,25 @@ func flattenUser(d *schema.ResourceData, in *managementClient.User) error {
 
 }
 
+// flattenUserPrincipalID returns the external principal ID of the user. configured is kept while it's a user principal.
+// Users with username and without configured principal are local users, that may be linked to external principals at
+// login, so principal_id isn't set for them
+func flattenUserPrincipalID(in *managementClient.User, configured string) string {
+	if len(configured) > 0 && slices.Contains(in.PrincipalIDs, configured) {
+		return configured
+	}
+	if len(configured) == 0 && len(in.Username) > 0 {
+		return ""
+	}
+	for _, id := range in.PrincipalIDs {
+		if !strings.HasPrefix(id, userLocalPrincipalPrefix) {
+			return id
+		}
+	}
+
+	return ""
+}
+
 // Expanders
 
 func expandUser(in *schema.ResourceData) *managementClient.User {
@@ -66,6 +90,10 @@ func expandUser(in *schema.ResourceData) *managementClient.User {
 		obj.PrincipalIDs = toArrayString(v)
 	}
 
+	if v, ok := in.Get("principal_id").(string); ok && len(v) > 0 {
+		obj.PrincipalIDs = []string{v}
+	}
+
 	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
 		obj.Annotations = toMapString(v)
 	}
@@ -76,3 +104,17 @@ func expandUser(in *schema.ResourceData) *managementClient.User {
 
 	return obj
 }
+
+// expandUserPrincipalPassword sets a random password, at least minLength long, to external principal users without
+// password. Rancher requires a password to create any user, external principal users log in through the auth provider
+func expandUserPrincipalPassword(in *managementClient.User, minLength int) {
+	if in == nil || len(in.PrincipalIDs) == 0 || len(in.Password) > 0 {
+		return
+	}
+
+	length := passDefaultLen
+	if minLength > length {
+		length = minLength
+	}
+	in.Password = GetRandomPass(length)
+}
@@ -9,8 +9,10 @@ import (
 )
 
 var (
-	testUserConf      *managementClient.User
-	testUserInterface map[string]interface{}
+	testUserConf                 *managementClient.User
+	testUserInterface            map[string]interface{}
+	testUserPrincipalIDConf      *managementClient.User
+	testUserPrincipalIDInterface map[string]interface{}
 )
 
 func init() {
@@ -26,22 +28,43 @@ func init() {
 		"enabled":              true,
 		"must_change_password": true,
 	}
+	testUserPrincipalIDConf = &managementClient.User{
+		Name:         "name",
+		Username:     "foo",
+		Enabled:      newTrue(),
+		PrincipalIDs: []string{"activedirectory_user://CN=foo,OU=users,DC=example,DC=com"},
+	}
+	testUserPrincipalIDInterface = map[string]interface{}{
+		"name":         "name",
+		"username":     "foo",
+		"enabled":      true,
+		"principal_id": "activedirectory_user://CN=foo,OU=users,DC=example,DC=com",
+	}
 }
 
 func TestFlattenUser(t *testing.T) {
 
 	cases := []struct {
 		Input          *managementClient.User
+		State          map[string]interface{}
 		ExpectedOutput map[string]interface{}
 	}{
 		{
 			testUserConf,
+			map[string]interface{}{},
 			testUserInterface,
 		},
+		{
+			testUserPrincipalIDConf,
+			map[string]interface{}{
+				"principal_id": "activedirectory_user://CN=foo,OU=users,DC=example,DC=com",
+			},
+			testUserPrincipalIDInterface,
+		},
 	}
 
 	for _, tc := range cases {
-		output := schema.TestResourceDataRaw(t, userFields(), map[string]interface{}{})
+		output := schema.TestResourceDataRaw(t, userFields(), tc.State)
 		err := flattenUser(output, tc.Input)
 		if err != nil {
 			assert.FailNow(t, "[ERROR] on flattener: %#v", err)
@@ -64,6 +87,10 @@ func TestExpandUser(t *testing.T) {
 			testUserInterface,
 			testUserConf,
 		},
+		{
+			testUserPrincipalIDInterface,
+			testUserPrincipalIDConf,
+		},
 	}
 
 	for _, tc := range cases {
@@ -72,3 +99,96 @@ func TestExpandUser(t *testing.T) {
 		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from expander.")
 	}
 }
+
+func TestExpandUserPrincipalPassword(t *testing.T) {
+
+	cases := []struct {
+		Name           string
+		Input          map[string]interface{}
+		MinLength      int
+		ExpectedLength int
+	}{
+		{
+			"principal user",
+			testUserPrincipalIDInterface,
+			userPasswordMinLengthDefault,
+			passDefaultLen,
+		},
+		{
+			"principal user with min length over default",
+			testUserPrincipalIDInterface,
+			32,
+			32,
+		},
+		{
+			"local user",
+			testUserInterface,
+			userPasswordMinLengthDefault,
+			0,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, userFields(), tc.Input)
+		output := expandUser(inputResourceData)
+		expandUserPrincipalPassword(output, tc.MinLength)
+		assert.Equal(t, tc.Input["username"], output.Username, "Unexpected username from expander: %s", tc.Name)
+		assert.Len(t, output.Password, tc.ExpectedLength, "Unexpected password length from expander: %s", tc.Name)
+		assert.NotEqual(t, output.Username, output.Password, "Unexpected password from expander: %s", tc.Name)
+	}
+
+	principalUser := expandUser(schema.TestResourceDataRaw(t, userFields(), map[string]interface{}{
+		"username":     "foo",
+		"password":     "configuredpassword",
+		"principal_id": "activedirectory_user://CN=foo,OU=users,DC=example,DC=com",
+	}))
+	expandUserPrincipalPassword(principalUser, userPasswordMinLengthDefault)
+	assert.Equal(t, "configuredpassword", principalUser.Password, "Unexpected configured password override from expander.")
+}
+
+func TestFlattenUserPrincipalID(t *testing.T) {
+	external := "activedirectory_user://CN=foo,OU=users,DC=example,DC=com"
+
+	cases := []struct {
+		Name           string
+		Input          *managementClient.User
+		Configured     string
+		ExpectedOutput string
+	}{
+		{
+			"external user",
+			&managementClient.User{PrincipalIDs: []string{"local://u-abcde", external}},
+			"",
+			external,
+		},
+		{
+			"configured external user",
+			&managementClient.User{PrincipalIDs: []string{external, "local://u-abcde"}},
+			external,
+			external,
+		},
+		{
+			"configured principal removed",
+			&managementClient.User{PrincipalIDs: []string{"local://u-abcde", "github_user://1"}},
+			external,
+			"github_user://1",
+		},
+		{
+			"local user",
+			&managementClient.User{Username: "username", PrincipalIDs: []string{"local://u-abcde"}},
+			"",
+			"",
+		},
+		{
+			"local user linked to external principal",
+			&managementClient.User{Username: "username", PrincipalIDs: []string{"local://u-abcde", external}},
+			"",
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenUserPrincipalID(tc.Input, tc.Configured)
+		assert.Equal(t, tc.ExpectedOutput, output, "Unexpected output from flattener for %s.", tc.Name)
+	}
+}
@@ -754,3 +754,15 @@ func structToMap(item interface{}) map[string]interface{} {
 
 	return res
 }
+
+func validateDuration(val interface{}, key string) (warns []string, errs []error) {
+	v, ok := val.(string)
+	if !ok || len(v) == 0 {
+		return
+	}
+	_, err := time.ParseDuration(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q must be in golang duration format, error: %v", key, err))
+	}
+	return
+}